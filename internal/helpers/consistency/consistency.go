@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
@@ -82,3 +83,51 @@ func WaitForUpdateWithTimeout(ctx context.Context, timeout time.Duration, f Chan
 	}
 	return res.(bool), err
 }
+
+// ScheduleRequestStatusFunc retrieves a privileged identity management schedule request, returning the request and its
+// status. A nil status indicates that the request was not found, which is expected shortly after it is created.
+type ScheduleRequestStatusFunc func(ctx context.Context) (interface{}, *string, error)
+
+// WaitForScheduleRequestStatus waits for a privileged identity management schedule request, such as a directory role or
+// privileged access group schedule request, to reach one of the target statuses. An error is returned if the request
+// fails, is denied, or is canceled or revoked before reaching a target status. The request is returned as retrieved by f.
+func WaitForScheduleRequestStatus(ctx context.Context, target []string, f ScheduleRequestStatusFunc) (interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, errors.New("context has no deadline")
+	}
+
+	return (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending: []string{
+			"Granted",
+			"PendingAdminDecision",
+			"PendingApproval",
+			"PendingProvisioning",
+			"PendingScheduleCreation",
+			"ScheduleCreated",
+		},
+		Target:     target,
+		Timeout:    time.Until(deadline),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			request, status, err := f(ctx)
+			if err != nil {
+				return nil, "Error", err
+			}
+			if status == nil {
+				return nil, "PendingScheduleCreation", nil
+			}
+
+			if slices.Contains(target, *status) {
+				return request, *status, nil
+			}
+
+			switch *status {
+			case "Canceled", "Denied", "Failed", "Revoked":
+				return nil, *status, fmt.Errorf("request is in a %s state", *status)
+			}
+
+			return request, *status, nil
+		},
+	}).WaitForStateContext(ctx)
+}
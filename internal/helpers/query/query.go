@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// DefaultPageSize is the maximum page size supported by most directory object collections
+const DefaultPageSize = 999
+
+// EscapeFilterValue escapes a string value for use inside a single-quoted literal in an OData $filter expression
+func EscapeFilterValue(value string) string {
+	return odata.EscapeSingleQuote(value)
+}
+
+// EscapeSearchValue escapes a string value for use inside a double-quoted clause in an OData $search expression
+func EscapeSearchValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
+
+// SearchClause returns a double-quoted $search clause matching the specified property against the specified value
+func SearchClause(property, value string) string {
+	return fmt.Sprintf(`"%s:%s"`, property, EscapeSearchValue(value))
+}
+
+// NormalizeSearch returns the provided $search expression, wrapping it in double quotes if it contains none
+func NormalizeSearch(search string) string {
+	search = strings.TrimSpace(search)
+	if search == "" || strings.Contains(search, `"`) {
+		return search
+	}
+	return fmt.Sprintf(`"%s"`, search)
+}
+
+// JoinFilters combines the non-empty $filter expressions with a logical AND. When more than one expression is
+// provided, each expression is parenthesized so that operator precedence is preserved.
+func JoinFilters(filters ...string) string {
+	clauses := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f = strings.TrimSpace(f); f != "" {
+			clauses = append(clauses, f)
+		}
+	}
+
+	if len(clauses) <= 1 {
+		return strings.Join(clauses, "")
+	}
+
+	for i, c := range clauses {
+		clauses[i] = fmt.Sprintf("(%s)", c)
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// Options describes an OData query against a directory object collection. When Advanced is true, the
+// `ConsistencyLevel: eventual` header and `$count=true` are included, as required by Microsoft Graph for advanced
+// queries and for any use of $search.
+type Options struct {
+	Advanced bool
+	Filter   string
+	Search   string
+	Select   []string
+	Top      int
+}
+
+var _ client.Options = Options{}
+
+func (o Options) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	return &out
+}
+
+func (o Options) ToOData() *odata.Query {
+	out := odata.Query{
+		Filter: o.Filter,
+		Select: o.Select,
+		Top:    o.Top,
+	}
+	if o.Advanced {
+		out.ConsistencyLevel = odata.ConsistencyLevelEventual
+		out.Count = true
+	}
+	return &out
+}
+
+func (o Options) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.Search != "" {
+		// The SDK always quotes the $search value, which prevents compound expressions, so it's passed through as-is
+		out.Append("$search", o.Search)
+	}
+	return &out
+}
+
+type page[T any] struct {
+	Count    *int        `json:"@odata.count"`
+	NextLink *odata.Link `json:"@odata.nextLink"`
+	Value    []T         `json:"value"`
+}
+
+// ListPages retrieves a directory object collection one page at a time, invoking the provided function with the items
+// in each page as it is received, so that large collections do not need to be held in memory in their entirety.
+// When the query is an advanced query, the total count of matching objects reported by the API is returned.
+func ListPages[T any](ctx context.Context, c client.BaseClient, path string, options Options, f func([]T) error) (*int, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Path:          path,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %v", err)
+	}
+
+	var count *int
+	for {
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var result page[T]
+		if err = resp.Unmarshal(&result); err != nil {
+			return nil, fmt.Errorf("unmarshaling page: %v", err)
+		}
+
+		if count == nil && result.Count != nil {
+			count = result.Count
+		}
+
+		if err = f(result.Value); err != nil {
+			return nil, err
+		}
+
+		if result.NextLink == nil || *result.NextLink == "" {
+			break
+		}
+
+		u, err := url.Parse(string(*result.NextLink))
+		if err != nil {
+			return nil, fmt.Errorf("parsing next page link: %v", err)
+		}
+
+		// The next link already contains all query parameters, headers are retained from the initial request
+		req.URL = u
+		req.Host = u.Host
+	}
+
+	return count, nil
+}
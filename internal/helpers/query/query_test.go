@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package query
+
+import (
+	"testing"
+)
+
+func TestSearchClause(t *testing.T) {
+	cases := []struct {
+		Property string
+		Value    string
+		Expected string
+		TestName string
+	}{
+		{
+			Property: "displayName",
+			Value:    "marketing",
+			Expected: `"displayName:marketing"`,
+			TestName: "Simple",
+		},
+		{
+			Property: "displayName",
+			Value:    `the "best" team`,
+			Expected: `"displayName:the \"best\" team"`,
+			TestName: "DoubleQuotes",
+		},
+		{
+			Property: "description",
+			Value:    `C:\temp`,
+			Expected: `"description:C:\\temp"`,
+			TestName: "Backslash",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := SearchClause(tc.Property, tc.Value); actual != tc.Expected {
+				t.Fatalf("Expected SearchClause to return %s, got %s", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeSearch(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected string
+		TestName string
+	}{
+		{
+			Value:    "displayName:marketing",
+			Expected: `"displayName:marketing"`,
+			TestName: "Unquoted",
+		},
+		{
+			Value:    `"displayName:marketing" OR "mail:marketing"`,
+			Expected: `"displayName:marketing" OR "mail:marketing"`,
+			TestName: "Quoted",
+		},
+		{
+			Value:    "  ",
+			Expected: "",
+			TestName: "Empty",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := NormalizeSearch(tc.Value); actual != tc.Expected {
+				t.Fatalf("Expected NormalizeSearch to return %s, got %s", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestJoinFilters(t *testing.T) {
+	cases := []struct {
+		Filters  []string
+		Expected string
+		TestName string
+	}{
+		{
+			Filters:  []string{},
+			Expected: "",
+			TestName: "None",
+		},
+		{
+			Filters:  []string{"", "mailEnabled eq true"},
+			Expected: "mailEnabled eq true",
+			TestName: "Single",
+		},
+		{
+			Filters:  []string{"mailEnabled eq true", "startsWith(displayName, 'a') or startsWith(displayName, 'b')"},
+			Expected: "(mailEnabled eq true) and (startsWith(displayName, 'a') or startsWith(displayName, 'b'))",
+			TestName: "Multiple",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := JoinFilters(tc.Filters...); actual != tc.Expected {
+				t.Fatalf("Expected JoinFilters to return %q, got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	options := Options{
+		Advanced: true,
+		Filter:   "endsWith(mail, '@contoso.com')",
+		Search:   `"displayName:marketing"`,
+		Select:   []string{"id"},
+		Top:      DefaultPageSize,
+	}
+
+	headers := options.ToOData().Headers()
+	if v := headers.Get("ConsistencyLevel"); v != "eventual" {
+		t.Fatalf("Expected ConsistencyLevel header to be %q, got %q", "eventual", v)
+	}
+
+	values := options.ToOData().Values()
+	if v := values.Get("$count"); v != "true" {
+		t.Fatalf("Expected $count to be %q, got %q", "true", v)
+	}
+	if v := values.Get("$filter"); v != options.Filter {
+		t.Fatalf("Expected $filter to be %q, got %q", options.Filter, v)
+	}
+	if v := values.Get("$search"); v != "" {
+		t.Fatalf("Expected $search to be omitted from OData values, got %q", v)
+	}
+
+	if v := options.ToQuery().Values().Get("$search"); v != options.Search {
+		t.Fatalf("Expected $search to be %q, got %q", options.Search, v)
+	}
+
+	if v := (Options{}).ToOData().Headers().Get("ConsistencyLevel"); v != "" {
+		t.Fatalf("Expected no ConsistencyLevel header for a basic query, got %q", v)
+	}
+}
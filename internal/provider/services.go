@@ -27,6 +27,7 @@ import (
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return []sdk.TypedServiceRegistration{
 		applications.Registration{},
+		conditionalaccess.Registration{},
 		directoryroles.Registration{},
 		domains.Registration{},
 		policies.Registration{},
@@ -4,9 +4,12 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessnamedlocation"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesstemplate"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
 )
 
 // CAUTION!
@@ -16,13 +19,41 @@ import (
 // For this reason, we are bound to using the Stable API here, as to use the Beta API, even to update a single property
 // for a Conditional Access Policy, will break that policy for users. The only way to go back to the Stable API after
 // breaking a policy in this way, is to delete and recreate it, which is wholly undesirable for a critical security resource.
+//
+// The only exception is for policies which specify session controls that are only available in the Beta API (token
+// protection and continuous access evaluation). Such policies are written and read exclusively with the Beta API, and
+// removing these session controls requires the policy to be recreated.
 
 type Client struct {
-	PolicyClient        *conditionalaccesspolicy.ConditionalAccessPolicyClient
-	NamedLocationClient *conditionalaccessnamedlocation.ConditionalAccessNamedLocationClient
+	AuthenticationContextClient *conditionalaccessauthenticationcontextclassreference.ConditionalAccessAuthenticationContextClassReferenceClient
+	ExtendedPolicyClient        *extendedpolicy.PolicyClient
+	ExtendedPolicyBetaClient    *extendedpolicy.PolicyClient
+	PolicyClient                *conditionalaccesspolicy.ConditionalAccessPolicyClient
+	NamedLocationClient         *conditionalaccessnamedlocation.ConditionalAccessNamedLocationClient
+	TemplateClient              *conditionalaccesstemplate.ConditionalAccessTemplateClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationContextClient, err := conditionalaccessauthenticationcontextclassreference.NewConditionalAccessAuthenticationContextClassReferenceClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationContextClient.Client)
+
+	// authenticationFlows and insiderRiskLevels conditions are not modelled by go-azure-sdk for the v1.0 API
+	extendedPolicyClient, err := extendedpolicy.NewPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(extendedPolicyClient.Client)
+
+	// secureSignInSession and continuousAccessEvaluation session controls are only available in the beta API
+	extendedPolicyBetaClient, err := extendedpolicy.NewBetaPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(extendedPolicyBetaClient.Client)
+
 	policyClient, err := conditionalaccesspolicy.NewConditionalAccessPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -35,8 +66,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(namedLocationClient.Client)
 
+	templateClient, err := conditionalaccesstemplate.NewConditionalAccessTemplateClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(templateClient.Client)
+
 	return &Client{
-		PolicyClient:        policyClient,
-		NamedLocationClient: namedLocationClient,
+		AuthenticationContextClient: authenticationContextClient,
+		ExtendedPolicyClient:        extendedPolicyClient,
+		ExtendedPolicyBetaClient:    extendedPolicyBetaClient,
+		PolicyClient:                policyClient,
+		NamedLocationClient:         namedLocationClient,
+		TemplateClient:              templateClient,
 	}, nil
 }
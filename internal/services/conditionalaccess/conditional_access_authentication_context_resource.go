@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+const conditionalAccessAuthenticationContextResourceName = "azuread_conditional_access_authentication_context"
+
+func conditionalAccessAuthenticationContextResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: conditionalAccessAuthenticationContextResourceCreate,
+		ReadContext:   conditionalAccessAuthenticationContextResourceRead,
+		UpdateContext: conditionalAccessAuthenticationContextResourceUpdate,
+		DeleteContext: conditionalAccessAuthenticationContextResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateIdentityConditionalAccessAuthenticationContextClassReferenceID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"context_id": {
+				Description:  "The identifier of the authentication context class reference, from `c1` to `c99`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^c([1-9]|[1-9][0-9])$`), "must be a value from `c1` to `c99`"),
+			},
+
+			"display_name": {
+				Description:  "The friendly name of the authentication context",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description: "A short explanation of the policies that are enforced by the authentication context",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"is_available": {
+				Description: "Whether the authentication context has been published and is available for use by apps",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func conditionalAccessAuthenticationContextResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClient
+
+	id := stable.NewIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Get("context_id").(string))
+
+	tf.LockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+	defer tf.UnlockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+
+	resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag(conditionalAccessAuthenticationContextResourceName, id.ID())
+	}
+
+	properties := stable.AuthenticationContextClassReference{
+		Id:          pointer.To(id.AuthenticationContextClassReferenceId),
+		Description: nullable.NoZero(d.Get("description").(string)),
+		DisplayName: nullable.Value(d.Get("display_name").(string)),
+		IsAvailable: nullable.Value(d.Get("is_available").(bool)),
+	}
+
+	// Authentication contexts have predefined IDs and are created by way of an upsert against the desired ID
+	if _, err = client.UpdateConditionalAccessAuthenticationContextClassReference(ctx, id, properties, conditionalaccessauthenticationcontextclassreference.DefaultUpdateConditionalAccessAuthenticationContextClassReferenceOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Creating %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	// Wait for the authentication context to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return conditionalAccessAuthenticationContextResourceRead(ctx, d, meta)
+}
+
+func conditionalAccessAuthenticationContextResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context ID")
+	}
+
+	tf.LockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+	defer tf.UnlockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+
+	properties := stable.AuthenticationContextClassReference{
+		Description: nullable.Value(d.Get("description").(string)),
+		DisplayName: nullable.Value(d.Get("display_name").(string)),
+		IsAvailable: nullable.Value(d.Get("is_available").(bool)),
+	}
+
+	if _, err = client.UpdateConditionalAccessAuthenticationContextClassReference(ctx, *id, properties, conditionalaccessauthenticationcontextclassreference.DefaultUpdateConditionalAccessAuthenticationContextClassReferenceOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return conditionalAccessAuthenticationContextResourceRead(ctx, d, meta)
+}
+
+func conditionalAccessAuthenticationContextResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context ID")
+	}
+
+	resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	authContext := resp.Model
+	if authContext == nil {
+		return tf.ErrorDiagF(errors.New("returned model was nil"), "Bad API Response")
+	}
+
+	tf.Set(d, "context_id", id.AuthenticationContextClassReferenceId)
+	tf.Set(d, "description", authContext.Description.GetOrZero())
+	tf.Set(d, "display_name", authContext.DisplayName.GetOrZero())
+	tf.Set(d, "is_available", authContext.IsAvailable.GetOrZero())
+
+	return nil
+}
+
+func conditionalAccessAuthenticationContextResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context ID")
+	}
+
+	tf.LockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+	defer tf.UnlockByName(conditionalAccessAuthenticationContextResourceName, id.AuthenticationContextClassReferenceId)
+
+	if resp, err := client.DeleteConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultDeleteConditionalAccessAuthenticationContextClassReferenceOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ConditionalAccessAuthenticationContextResource struct{}
+
+func TestAccConditionalAccessAuthenticationContext_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_authentication_context", "test")
+	r := ConditionalAccessAuthenticationContextResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_available").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessAuthenticationContext_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_authentication_context", "test")
+	r := ConditionalAccessAuthenticationContextResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_available").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessAuthenticationContext_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_authentication_context", "test")
+	r := ConditionalAccessAuthenticationContextResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessAuthenticationContext_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_authentication_context", "test")
+	r := ConditionalAccessAuthenticationContextResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ConditionalAccessAuthenticationContextResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ConditionalAccess.AuthenticationContextClient.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ConditionalAccessAuthenticationContextResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_conditional_access_authentication_context" "test" {
+  context_id   = "c%[2]d"
+  display_name = "acctest-AUTHCONTEXT-%[1]d"
+}
+`, data.RandomInteger, data.RandomInteger%99+1)
+}
+
+func (ConditionalAccessAuthenticationContextResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_conditional_access_authentication_context" "test" {
+  context_id   = "c%[2]d"
+  display_name = "acctest-AUTHCONTEXT-%[1]d-updated"
+  description  = "Acceptance test authentication context"
+  is_available = true
+}
+`, data.RandomInteger, data.RandomInteger%99+1)
+}
+
+func (r ConditionalAccessAuthenticationContextResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_conditional_access_authentication_context" "import" {
+  context_id   = azuread_conditional_access_authentication_context.test.context_id
+  display_name = azuread_conditional_access_authentication_context.test.display_name
+}
+`, r.basic(data))
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
+)
+
+const (
+	conditionalAccessAll                   = "All"
+	conditionalAccessAllTrusted            = "AllTrusted"
+	conditionalAccessGuestsOrExternalUsers = "GuestsOrExternalUsers"
+)
+
+// conditionalAccessSignIn describes a hypothetical user sign-in, against which conditional access policies can be evaluated
+type conditionalAccessSignIn struct {
+	UserId                  string
+	GroupIds                []string
+	RoleTemplateIds         []string
+	GuestOrExternalUserType string
+	ExternalTenantId        string
+
+	ApplicationId         string
+	UserAction            string
+	AuthenticationContext string
+
+	AuthenticationFlow string
+	ClientAppType      string
+	DevicePlatform     string
+	LocationId         string
+	TrustedLocation    bool
+
+	InsiderRiskLevel string
+	SignInRiskLevel  string
+	UserRiskLevel    string
+}
+
+// conditionalAccessPolicyEvaluation is the outcome of evaluating a single conditional access policy against a sign-in
+type conditionalAccessPolicyEvaluation struct {
+	Applies               bool
+	NotApplicableReasons  []string
+	UnevaluatedConditions []string
+}
+
+// evaluateConditionalAccessPolicy determines whether the provided policy would apply to the described sign-in. The
+// evaluation is performed locally, so conditions which depend on information not known ahead of time (such as device
+// and application filters) are not evaluated and are instead reported in UnevaluatedConditions.
+func evaluateConditionalAccessPolicy(policy extendedpolicy.ConditionalAccessPolicy, signIn conditionalAccessSignIn) conditionalAccessPolicyEvaluation {
+	result := conditionalAccessPolicyEvaluation{
+		NotApplicableReasons:  make([]string, 0),
+		UnevaluatedConditions: make([]string, 0),
+	}
+
+	if pointer.From(policy.State) == stable.ConditionalAccessPolicyState_Disabled {
+		result.NotApplicableReasons = append(result.NotApplicableReasons, "policy is disabled")
+	}
+
+	if conditions := policy.Conditions; conditions == nil {
+		result.NotApplicableReasons = append(result.NotApplicableReasons, "policy has no conditions")
+	} else {
+		for _, reason := range []string{
+			conditionalAccessUsersNotApplicableReason(conditions.Users, signIn),
+			conditionalAccessApplicationsNotApplicableReason(conditions.Applications, signIn),
+			conditionalAccessAuthenticationFlowsNotApplicableReason(conditions.AuthenticationFlows, signIn),
+			conditionalAccessClientAppTypesNotApplicableReason(conditions.ClientAppTypes, signIn),
+			conditionalAccessPlatformsNotApplicableReason(conditions.Platforms, signIn),
+			conditionalAccessLocationsNotApplicableReason(conditions.Locations, signIn),
+			conditionalAccessInsiderRiskLevelsNotApplicableReason(conditions.InsiderRiskLevels, signIn),
+			conditionalAccessRiskLevelsNotApplicableReason(conditions.SignInRiskLevels, signIn.SignInRiskLevel, "sign-in"),
+			conditionalAccessRiskLevelsNotApplicableReason(conditions.UserRiskLevels, signIn.UserRiskLevel, "user"),
+		} {
+			if reason != "" {
+				result.NotApplicableReasons = append(result.NotApplicableReasons, reason)
+			}
+		}
+
+		if len(pointer.From(conditions.ServicePrincipalRiskLevels)) > 0 {
+			result.NotApplicableReasons = append(result.NotApplicableReasons, "service principal risk levels only apply to workload identities")
+		}
+
+		if conditions.Applications.ApplicationFilter != nil {
+			result.UnevaluatedConditions = append(result.UnevaluatedConditions, "applications.application_filter")
+		}
+		if conditions.Devices != nil && conditions.Devices.DeviceFilter != nil {
+			result.UnevaluatedConditions = append(result.UnevaluatedConditions, "devices.filter")
+		}
+	}
+
+	result.Applies = len(result.NotApplicableReasons) == 0
+
+	return result
+}
+
+func conditionalAccessUsersNotApplicableReason(in *stable.ConditionalAccessUsers, signIn conditionalAccessSignIn) string {
+	if in == nil {
+		return "policy does not target users"
+	}
+
+	matches := func(users, groups, roles *[]string, guests *stable.ConditionalAccessGuestsOrExternalUsers) bool {
+		for _, user := range pointer.From(users) {
+			switch {
+			case strings.EqualFold(user, conditionalAccessAll):
+				return true
+			case strings.EqualFold(user, conditionalAccessGuestsOrExternalUsers):
+				if signIn.GuestOrExternalUserType != "" && signIn.GuestOrExternalUserType != string(stable.ConditionalAccessGuestOrExternalUserTypes_None) {
+					return true
+				}
+			case signIn.UserId != "" && strings.EqualFold(user, signIn.UserId):
+				return true
+			}
+		}
+
+		return conditionalAccessContainsAny(pointer.From(groups), signIn.GroupIds) ||
+			conditionalAccessContainsAny(pointer.From(roles), signIn.RoleTemplateIds) ||
+			conditionalAccessGuestsOrExternalUsersMatch(guests, signIn)
+	}
+
+	if !matches(in.IncludeUsers, in.IncludeGroups, in.IncludeRoles, in.IncludeGuestsOrExternalUsers) {
+		return "user is not included"
+	}
+	if matches(in.ExcludeUsers, in.ExcludeGroups, in.ExcludeRoles, in.ExcludeGuestsOrExternalUsers) {
+		return "user is excluded"
+	}
+
+	return ""
+}
+
+func conditionalAccessGuestsOrExternalUsersMatch(in *stable.ConditionalAccessGuestsOrExternalUsers, signIn conditionalAccessSignIn) bool {
+	if in == nil || signIn.GuestOrExternalUserType == "" {
+		return false
+	}
+
+	if !conditionalAccessContains(strings.Split(string(pointer.From(in.GuestOrExternalUserTypes)), ","), signIn.GuestOrExternalUserType) {
+		return false
+	}
+
+	// Internal guests do not belong to an external tenant, so are not subject to the external tenants condition
+	if in.ExternalTenants == nil || strings.EqualFold(signIn.GuestOrExternalUserType, string(stable.ConditionalAccessGuestOrExternalUserTypes_InternalGuest)) {
+		return true
+	}
+
+	externalTenants := in.ExternalTenants.ConditionalAccessExternalTenants()
+	if pointer.From(externalTenants.MembershipKind) != stable.ConditionalAccessExternalTenantsMembershipKind_Enumerated {
+		return true
+	}
+
+	return signIn.ExternalTenantId != "" && conditionalAccessContains(pointer.From(externalTenants.Members), signIn.ExternalTenantId)
+}
+
+func conditionalAccessApplicationsNotApplicableReason(in stable.ConditionalAccessApplications, signIn conditionalAccessSignIn) string {
+	switch {
+	case signIn.UserAction != "":
+		if !conditionalAccessContains(pointer.From(in.IncludeUserActions), signIn.UserAction) {
+			return "user action is not included"
+		}
+
+	case signIn.AuthenticationContext != "":
+		if !conditionalAccessContains(pointer.From(in.IncludeAuthenticationContextClassReferences), signIn.AuthenticationContext) {
+			return "authentication context is not included"
+		}
+
+	default:
+		includeApplications := pointer.From(in.IncludeApplications)
+		if !conditionalAccessContains(includeApplications, conditionalAccessAll) && !conditionalAccessContains(includeApplications, signIn.ApplicationId) {
+			return "application is not included"
+		}
+		if conditionalAccessContains(pointer.From(in.ExcludeApplications), signIn.ApplicationId) {
+			return "application is excluded"
+		}
+	}
+
+	return ""
+}
+
+func conditionalAccessAuthenticationFlowsNotApplicableReason(in *extendedpolicy.ConditionalAccessAuthenticationFlows, signIn conditionalAccessSignIn) string {
+	if in == nil || in.TransferMethods == nil {
+		return ""
+	}
+
+	transferMethods := make([]string, 0)
+	for _, v := range strings.Split(string(*in.TransferMethods), ",") {
+		if v = strings.TrimSpace(v); v != "" && v != string(extendedpolicy.ConditionalAccessTransferMethods_None) {
+			transferMethods = append(transferMethods, v)
+		}
+	}
+
+	if len(transferMethods) > 0 && !conditionalAccessContains(transferMethods, signIn.AuthenticationFlow) {
+		return "authentication flow is not included"
+	}
+
+	return ""
+}
+
+func conditionalAccessClientAppTypesNotApplicableReason(in []stable.ConditionalAccessClientApp, signIn conditionalAccessSignIn) string {
+	// When no client app types are configured, the policy applies to all client apps
+	if len(in) == 0 {
+		return ""
+	}
+
+	for _, v := range in {
+		if v == stable.ConditionalAccessClientApp_All || strings.EqualFold(string(v), signIn.ClientAppType) {
+			return ""
+		}
+	}
+
+	return "client app type is not included"
+}
+
+func conditionalAccessPlatformsNotApplicableReason(in *stable.ConditionalAccessPlatforms, signIn conditionalAccessSignIn) string {
+	if in == nil {
+		return ""
+	}
+
+	matches := func(platforms *[]stable.ConditionalAccessDevicePlatform) bool {
+		for _, v := range pointer.From(platforms) {
+			if v == stable.ConditionalAccessDevicePlatform_All || (signIn.DevicePlatform != "" && strings.EqualFold(string(v), signIn.DevicePlatform)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !matches(in.IncludePlatforms) {
+		return "device platform is not included"
+	}
+	if signIn.DevicePlatform != "" && matches(in.ExcludePlatforms) {
+		return "device platform is excluded"
+	}
+
+	return ""
+}
+
+func conditionalAccessLocationsNotApplicableReason(in *stable.ConditionalAccessLocations, signIn conditionalAccessSignIn) string {
+	if in == nil {
+		return ""
+	}
+
+	matches := func(locations *[]string) bool {
+		for _, v := range pointer.From(locations) {
+			switch {
+			case strings.EqualFold(v, conditionalAccessAll):
+				return true
+			case strings.EqualFold(v, conditionalAccessAllTrusted):
+				if signIn.TrustedLocation {
+					return true
+				}
+			case signIn.LocationId != "" && strings.EqualFold(v, signIn.LocationId):
+				return true
+			}
+		}
+		return false
+	}
+
+	if !matches(in.IncludeLocations) {
+		return "location is not included"
+	}
+	if matches(in.ExcludeLocations) {
+		return "location is excluded"
+	}
+
+	return ""
+}
+
+func conditionalAccessRiskLevelsNotApplicableReason(in []stable.RiskLevel, level, kind string) string {
+	if len(in) == 0 {
+		return ""
+	}
+
+	if level == "" {
+		level = string(stable.RiskLevel_None)
+	}
+
+	for _, v := range in {
+		if strings.EqualFold(string(v), level) {
+			return ""
+		}
+	}
+
+	return kind + " risk level is not included"
+}
+
+func conditionalAccessInsiderRiskLevelsNotApplicableReason(in *stable.ConditionalAccessInsiderRiskLevels, signIn conditionalAccessSignIn) string {
+	if in == nil || *in == "" {
+		return ""
+	}
+
+	if !conditionalAccessContains(strings.Split(string(*in), ","), signIn.InsiderRiskLevel) {
+		return "insider risk level is not included"
+	}
+
+	return ""
+}
+
+// conditionalAccessGrantControlsResult describes the grant controls required by a set of conditional access policies
+type conditionalAccessGrantControlsResult struct {
+	Blocked                         bool
+	BuiltInControls                 []string
+	AuthenticationStrengthPolicyIds []string
+	TermsOfUse                      []string
+
+	// Alternatives holds the controls of each policy using the OR operator with more than one control, any one of
+	// which satisfies that policy
+	Alternatives []conditionalAccessGrantControlAlternative
+}
+
+type conditionalAccessGrantControlAlternative struct {
+	PolicyId                       string
+	BuiltInControls                []string
+	AuthenticationStrengthPolicyId string
+	TermsOfUse                     []string
+}
+
+// aggregateConditionalAccessGrantControls combines the grant controls of the provided policies. Every applicable policy
+// must be satisfied, so controls of policies using the AND operator (or having a single control) are always required
+// and are returned as the sorted union of those controls. Policies using the OR operator with more than one control can
+// be satisfied by any one of them, so their controls are returned separately as alternatives.
+func aggregateConditionalAccessGrantControls(policies []extendedpolicy.ConditionalAccessPolicy) conditionalAccessGrantControlsResult {
+	result := conditionalAccessGrantControlsResult{
+		BuiltInControls:                 make([]string, 0),
+		AuthenticationStrengthPolicyIds: make([]string, 0),
+		TermsOfUse:                      make([]string, 0),
+		Alternatives:                    make([]conditionalAccessGrantControlAlternative, 0),
+	}
+
+	for _, policy := range policies {
+		grantControls := policy.GrantControls
+		if grantControls == nil {
+			continue
+		}
+
+		builtInControls := make([]string, 0)
+		for _, v := range pointer.From(grantControls.BuiltInControls) {
+			// Block cannot be combined with other controls, so it always applies regardless of the operator
+			if v == stable.ConditionalAccessGrantControl_Block {
+				result.Blocked = true
+			}
+			builtInControls = append(builtInControls, string(v))
+		}
+
+		authenticationStrengthPolicyId := ""
+		if grantControls.AuthenticationStrength != nil && grantControls.AuthenticationStrength.Id != nil {
+			authenticationStrengthPolicyId = stable.NewPolicyAuthenticationStrengthPolicyID(*grantControls.AuthenticationStrength.Id).ID()
+		}
+
+		termsOfUse := slices.Clone(pointer.From(grantControls.TermsOfUse))
+
+		controlCount := len(builtInControls) + len(termsOfUse)
+		if authenticationStrengthPolicyId != "" {
+			controlCount++
+		}
+
+		if strings.EqualFold(grantControls.Operator.GetOrZero(), "OR") && controlCount > 1 {
+			alternative := conditionalAccessGrantControlAlternative{
+				BuiltInControls:                builtInControls,
+				AuthenticationStrengthPolicyId: authenticationStrengthPolicyId,
+				TermsOfUse:                     termsOfUse,
+			}
+			if policy.Id != nil {
+				alternative.PolicyId = stable.NewIdentityConditionalAccessPolicyID(*policy.Id).ID()
+			}
+			sort.Strings(alternative.BuiltInControls)
+			sort.Strings(alternative.TermsOfUse)
+			result.Alternatives = append(result.Alternatives, alternative)
+			continue
+		}
+
+		for _, v := range builtInControls {
+			if !slices.Contains(result.BuiltInControls, v) {
+				result.BuiltInControls = append(result.BuiltInControls, v)
+			}
+		}
+
+		if authenticationStrengthPolicyId != "" && !slices.Contains(result.AuthenticationStrengthPolicyIds, authenticationStrengthPolicyId) {
+			result.AuthenticationStrengthPolicyIds = append(result.AuthenticationStrengthPolicyIds, authenticationStrengthPolicyId)
+		}
+
+		for _, v := range termsOfUse {
+			if !slices.Contains(result.TermsOfUse, v) {
+				result.TermsOfUse = append(result.TermsOfUse, v)
+			}
+		}
+	}
+
+	sort.Strings(result.BuiltInControls)
+	sort.Strings(result.AuthenticationStrengthPolicyIds)
+	sort.Strings(result.TermsOfUse)
+
+	return result
+}
+
+// aggregateConditionalAccessSessionControls combines the session controls of the provided policies, selecting the most
+// restrictive value for each control in the same way as the service does when multiple policies apply
+func aggregateConditionalAccessSessionControls(policies []extendedpolicy.ConditionalAccessPolicy) []interface{} {
+	cloudAppSecurityRank := map[string]int{
+		"": 0,
+		string(stable.CloudAppSecuritySessionControlType_MonitorOnly):    1,
+		string(stable.CloudAppSecuritySessionControlType_McasConfigured): 2,
+		string(stable.CloudAppSecuritySessionControlType_BlockDownloads): 3,
+	}
+
+	applicationEnforcedRestrictions := false
+	cloudAppSecurity := ""
+	persistentBrowserMode := ""
+	signInFrequency := 0
+	signInFrequencyHours := 0
+	signInFrequencyInterval := ""
+	signInFrequencyPeriod := ""
+
+	for _, policy := range policies {
+		sessionControls := policy.SessionControls
+		if sessionControls == nil {
+			continue
+		}
+
+		if sessionControls.ApplicationEnforcedRestrictions != nil && sessionControls.ApplicationEnforcedRestrictions.IsEnabled.GetOrZero() {
+			applicationEnforcedRestrictions = true
+		}
+
+		if sessionControls.CloudAppSecurity != nil && sessionControls.CloudAppSecurity.IsEnabled.GetOrZero() {
+			if v := string(pointer.From(sessionControls.CloudAppSecurity.CloudAppSecurityType)); cloudAppSecurityRank[v] > cloudAppSecurityRank[cloudAppSecurity] {
+				cloudAppSecurity = v
+			}
+		}
+
+		if sessionControls.PersistentBrowser != nil && sessionControls.PersistentBrowser.IsEnabled.GetOrZero() {
+			if v := string(pointer.From(sessionControls.PersistentBrowser.Mode)); persistentBrowserMode != string(stable.PersistentBrowserSessionMode_Never) {
+				persistentBrowserMode = v
+			}
+		}
+
+		if f := sessionControls.SignInFrequency; f != nil && f.IsEnabled.GetOrZero() && signInFrequencyInterval != string(stable.SignInFrequencyInterval_EveryTime) {
+			value := int(f.Value.GetOrZero())
+			hours := value
+			if pointer.From(f.Type) == stable.SigninFrequencyType_Days {
+				hours = value * 24
+			}
+
+			if pointer.From(f.FrequencyInterval) == stable.SignInFrequencyInterval_EveryTime {
+				signInFrequency, signInFrequencyPeriod = 0, ""
+				signInFrequencyInterval = string(stable.SignInFrequencyInterval_EveryTime)
+			} else if value > 0 && (signInFrequencyHours == 0 || hours < signInFrequencyHours) {
+				signInFrequency, signInFrequencyHours = value, hours
+				signInFrequencyInterval = string(stable.SignInFrequencyInterval_TimeBased)
+				signInFrequencyPeriod = string(pointer.From(f.Type))
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"application_enforced_restrictions_enabled": applicationEnforcedRestrictions,
+			"cloud_app_security_policy":                 cloudAppSecurity,
+			"persistent_browser_mode":                   persistentBrowserMode,
+			"sign_in_frequency":                         signInFrequency,
+			"sign_in_frequency_interval":                signInFrequencyInterval,
+			"sign_in_frequency_period":                  signInFrequencyPeriod,
+		},
+	}
+}
+
+func conditionalAccessContains(values []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionalAccessContainsAny(values []string, candidates []string) bool {
+	for _, c := range candidates {
+		if conditionalAccessContains(values, c) {
+			return true
+		}
+	}
+	return false
+}
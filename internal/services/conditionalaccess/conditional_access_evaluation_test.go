@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
+)
+
+const (
+	testBreakGlassUserId      = "00000000-0000-0000-0000-000000000001"
+	testUserId                = "00000000-0000-0000-0000-000000000002"
+	testAdminsGroupId         = "00000000-0000-0000-0000-000000000003"
+	testGlobalAdminRoleId     = "62e90394-69f5-4237-9190-012177145e10"
+	testApplicationId         = "00000000-0000-0000-0000-000000000004"
+	testTrustedLocationId     = "00000000-0000-0000-0000-000000000005"
+	testExternalTenantId      = "00000000-0000-0000-0000-000000000006"
+	testOtherExternalTenantId = "00000000-0000-0000-0000-000000000007"
+)
+
+func testConditionalAccessPolicy(conditions stable.ConditionalAccessConditionSet, controls ...stable.ConditionalAccessGrantControl) extendedpolicy.ConditionalAccessPolicy {
+	return extendedpolicy.ConditionalAccessPolicy{
+		ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			Id:          pointer.To("00000000-0000-0000-0000-0000000000aa"),
+			DisplayName: pointer.To("test"),
+			State:       pointer.To(stable.ConditionalAccessPolicyState_Enabled),
+			GrantControls: &stable.ConditionalAccessGrantControls{
+				Operator:        nullable.Value("OR"),
+				BuiltInControls: &controls,
+			},
+		},
+		Conditions: &extendedpolicy.ConditionalAccessConditionSet{
+			ConditionalAccessConditionSet: conditions,
+		},
+	}
+}
+
+func TestEvaluateConditionalAccessPolicy(t *testing.T) {
+	allUsersAllApps := stable.ConditionalAccessConditionSet{
+		Applications: stable.ConditionalAccessApplications{
+			IncludeApplications: pointer.To([]string{"All"}),
+		},
+		ClientAppTypes: []stable.ConditionalAccessClientApp{stable.ConditionalAccessClientApp_All},
+		Users: &stable.ConditionalAccessUsers{
+			IncludeUsers: pointer.To([]string{"All"}),
+			ExcludeUsers: pointer.To([]string{testBreakGlassUserId}),
+		},
+	}
+
+	adminRoles := stable.ConditionalAccessConditionSet{
+		Applications: stable.ConditionalAccessApplications{
+			IncludeApplications: pointer.To([]string{"All"}),
+		},
+		ClientAppTypes: []stable.ConditionalAccessClientApp{stable.ConditionalAccessClientApp_All},
+		Users: &stable.ConditionalAccessUsers{
+			IncludeRoles:  pointer.To([]string{testGlobalAdminRoleId}),
+			ExcludeGroups: pointer.To([]string{testAdminsGroupId}),
+		},
+	}
+
+	untrustedLocations := stable.ConditionalAccessConditionSet{
+		Applications: stable.ConditionalAccessApplications{
+			IncludeApplications: pointer.To([]string{testApplicationId}),
+		},
+		ClientAppTypes: []stable.ConditionalAccessClientApp{stable.ConditionalAccessClientApp_Browser},
+		Locations: &stable.ConditionalAccessLocations{
+			IncludeLocations: pointer.To([]string{"All"}),
+			ExcludeLocations: pointer.To([]string{"AllTrusted"}),
+		},
+		Platforms: &stable.ConditionalAccessPlatforms{
+			IncludePlatforms: pointer.To([]stable.ConditionalAccessDevicePlatform{stable.ConditionalAccessDevicePlatform_All}),
+			ExcludePlatforms: pointer.To([]stable.ConditionalAccessDevicePlatform{stable.ConditionalAccessDevicePlatform_IOS}),
+		},
+		SignInRiskLevels: []stable.RiskLevel{stable.RiskLevel_Medium, stable.RiskLevel_High},
+		Users: &stable.ConditionalAccessUsers{
+			IncludeUsers: pointer.To([]string{"All"}),
+		},
+	}
+
+	guests := stable.ConditionalAccessConditionSet{
+		Applications: stable.ConditionalAccessApplications{
+			IncludeUserActions: pointer.To([]string{"urn:user:registersecurityinfo"}),
+		},
+		ClientAppTypes: []stable.ConditionalAccessClientApp{stable.ConditionalAccessClientApp_All},
+		Users: &stable.ConditionalAccessUsers{
+			IncludeGuestsOrExternalUsers: &stable.ConditionalAccessGuestsOrExternalUsers{
+				GuestOrExternalUserTypes: pointer.To(stable.ConditionalAccessGuestOrExternalUserTypes("internalGuest,b2bCollaborationGuest")),
+				ExternalTenants: stable.ConditionalAccessEnumeratedExternalTenants{
+					MembershipKind: pointer.To(stable.ConditionalAccessExternalTenantsMembershipKind_Enumerated),
+					Members:        pointer.To([]string{testExternalTenantId}),
+				},
+			},
+		},
+	}
+
+	browserSignIn := conditionalAccessSignIn{
+		UserId:        testUserId,
+		ApplicationId: testApplicationId,
+		ClientAppType: "browser",
+	}
+
+	cases := []struct {
+		TestName    string
+		Policy      extendedpolicy.ConditionalAccessPolicy
+		SignIn      conditionalAccessSignIn
+		Applies     bool
+		Reasons     []string
+		Unevaluated []string
+	}{
+		{
+			TestName: "AllUsers_Included",
+			Policy:   testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn:   browserSignIn,
+			Applies:  true,
+		},
+		{
+			TestName: "AllUsers_BreakGlassExcluded",
+			Policy:   testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Block),
+			SignIn: conditionalAccessSignIn{
+				UserId:        testBreakGlassUserId,
+				ApplicationId: testApplicationId,
+				ClientAppType: "browser",
+			},
+			Reasons: []string{"user is excluded"},
+		},
+		{
+			TestName: "Disabled",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				p := testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Mfa)
+				p.State = pointer.To(stable.ConditionalAccessPolicyState_Disabled)
+				return p
+			}(),
+			SignIn:  browserSignIn,
+			Reasons: []string{"policy is disabled"},
+		},
+		{
+			TestName: "AdminRole_Included",
+			Policy:   testConditionalAccessPolicy(adminRoles, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				UserId:          testUserId,
+				RoleTemplateIds: []string{testGlobalAdminRoleId},
+				ApplicationId:   testApplicationId,
+				ClientAppType:   "mobileAppsAndDesktopClients",
+			},
+			Applies: true,
+		},
+		{
+			TestName: "AdminRole_ExcludedByGroup",
+			Policy:   testConditionalAccessPolicy(adminRoles, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				UserId:          testUserId,
+				GroupIds:        []string{testAdminsGroupId},
+				RoleTemplateIds: []string{testGlobalAdminRoleId},
+				ApplicationId:   testApplicationId,
+				ClientAppType:   "browser",
+			},
+			Reasons: []string{"user is excluded"},
+		},
+		{
+			TestName: "AdminRole_NotAssigned",
+			Policy:   testConditionalAccessPolicy(adminRoles, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn:   browserSignIn,
+			Reasons:  []string{"user is not included"},
+		},
+		{
+			TestName: "UntrustedLocation_RiskySignIn",
+			Policy:   testConditionalAccessPolicy(untrustedLocations, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				UserId:          testUserId,
+				ApplicationId:   testApplicationId,
+				ClientAppType:   "browser",
+				DevicePlatform:  "windows",
+				SignInRiskLevel: "high",
+			},
+			Applies: true,
+		},
+		{
+			TestName: "UntrustedLocation_Multiple",
+			Policy:   testConditionalAccessPolicy(untrustedLocations, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				UserId:          testUserId,
+				ApplicationId:   "00000000-0000-0000-0000-0000000000ff",
+				ClientAppType:   "exchangeActiveSync",
+				DevicePlatform:  "iOS",
+				LocationId:      testTrustedLocationId,
+				TrustedLocation: true,
+			},
+			Reasons: []string{
+				"application is not included",
+				"client app type is not included",
+				"device platform is excluded",
+				"location is excluded",
+				"sign-in risk level is not included",
+			},
+		},
+		{
+			TestName: "Guest_EnumeratedTenant",
+			Policy:   testConditionalAccessPolicy(guests, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				GuestOrExternalUserType: "b2bCollaborationGuest",
+				ExternalTenantId:        testExternalTenantId,
+				UserAction:              "urn:user:registersecurityinfo",
+				ClientAppType:           "browser",
+			},
+			Applies: true,
+		},
+		{
+			TestName: "Guest_OtherTenant",
+			Policy:   testConditionalAccessPolicy(guests, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				GuestOrExternalUserType: "b2bCollaborationGuest",
+				ExternalTenantId:        testOtherExternalTenantId,
+				UserAction:              "urn:user:registersecurityinfo",
+				ClientAppType:           "browser",
+			},
+			Reasons: []string{"user is not included"},
+		},
+		{
+			TestName: "Guest_ApplicationSignIn",
+			Policy:   testConditionalAccessPolicy(guests, stable.ConditionalAccessGrantControl_Mfa),
+			SignIn: conditionalAccessSignIn{
+				GuestOrExternalUserType: "internalGuest",
+				ApplicationId:           testApplicationId,
+				ClientAppType:           "browser",
+			},
+			Reasons: []string{"application is not included"},
+		},
+		{
+			TestName: "DeviceFilter_Unevaluated",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				conditions := allUsersAllApps
+				conditions.Devices = &stable.ConditionalAccessDevices{
+					DeviceFilter: &stable.ConditionalAccessFilter{
+						Mode: pointer.To(stable.FilterMode_Exclude),
+						Rule: pointer.To(`device.trustType -eq "ServerAD"`),
+					},
+				}
+				return testConditionalAccessPolicy(conditions, stable.ConditionalAccessGrantControl_Mfa)
+			}(),
+			SignIn:      browserSignIn,
+			Applies:     true,
+			Unevaluated: []string{"devices.filter"},
+		},
+		{
+			TestName: "DeviceCodeFlow_Included",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				p := testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Block)
+				p.Conditions.AuthenticationFlows = &extendedpolicy.ConditionalAccessAuthenticationFlows{
+					TransferMethods: pointer.To(extendedpolicy.ConditionalAccessTransferMethods("deviceCodeFlow,authenticationTransfer")),
+				}
+				return p
+			}(),
+			SignIn: conditionalAccessSignIn{
+				UserId:             testUserId,
+				ApplicationId:      testApplicationId,
+				AuthenticationFlow: "deviceCodeFlow",
+				ClientAppType:      "mobileAppsAndDesktopClients",
+			},
+			Applies: true,
+		},
+		{
+			TestName: "DeviceCodeFlow_NotUsed",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				p := testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Block)
+				p.Conditions.AuthenticationFlows = &extendedpolicy.ConditionalAccessAuthenticationFlows{
+					TransferMethods: pointer.To(extendedpolicy.ConditionalAccessTransferMethods_DeviceCodeFlow),
+				}
+				return p
+			}(),
+			SignIn:  browserSignIn,
+			Reasons: []string{"authentication flow is not included"},
+		},
+		{
+			TestName: "InsiderRisk_Elevated",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				p := testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Block)
+				p.Conditions.InsiderRiskLevels = pointer.To(stable.ConditionalAccessInsiderRiskLevels("moderate,elevated"))
+				return p
+			}(),
+			SignIn: conditionalAccessSignIn{
+				UserId:           testUserId,
+				ApplicationId:    testApplicationId,
+				ClientAppType:    "browser",
+				InsiderRiskLevel: "elevated",
+			},
+			Applies: true,
+		},
+		{
+			TestName: "InsiderRisk_NotIncluded",
+			Policy: func() extendedpolicy.ConditionalAccessPolicy {
+				p := testConditionalAccessPolicy(allUsersAllApps, stable.ConditionalAccessGrantControl_Block)
+				p.Conditions.InsiderRiskLevels = pointer.To(stable.ConditionalAccessInsiderRiskLevels_Elevated)
+				return p
+			}(),
+			SignIn:  browserSignIn,
+			Reasons: []string{"insider risk level is not included"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result := evaluateConditionalAccessPolicy(tc.Policy, tc.SignIn)
+
+			if result.Applies != tc.Applies {
+				t.Fatalf("Expected Applies to be %t, got %t (reasons: %v)", tc.Applies, result.Applies, result.NotApplicableReasons)
+			}
+
+			expectedReasons := tc.Reasons
+			if expectedReasons == nil {
+				expectedReasons = []string{}
+			}
+			if !reflect.DeepEqual(result.NotApplicableReasons, expectedReasons) {
+				t.Fatalf("Expected reasons %v, got %v", expectedReasons, result.NotApplicableReasons)
+			}
+
+			expectedUnevaluated := tc.Unevaluated
+			if expectedUnevaluated == nil {
+				expectedUnevaluated = []string{}
+			}
+			if !reflect.DeepEqual(result.UnevaluatedConditions, expectedUnevaluated) {
+				t.Fatalf("Expected unevaluated conditions %v, got %v", expectedUnevaluated, result.UnevaluatedConditions)
+			}
+		})
+	}
+}
+
+func TestAggregateConditionalAccessControls(t *testing.T) {
+	policies := []extendedpolicy.ConditionalAccessPolicy{
+		{
+			ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+				GrantControls: &stable.ConditionalAccessGrantControls{
+					BuiltInControls: pointer.To([]stable.ConditionalAccessGrantControl{stable.ConditionalAccessGrantControl_Mfa, stable.ConditionalAccessGrantControl_CompliantDevice}),
+				},
+			},
+			SessionControls: &extendedpolicy.ConditionalAccessSessionControls{
+				ConditionalAccessSessionControls: stable.ConditionalAccessSessionControls{
+					PersistentBrowser: &stable.PersistentBrowserSessionControl{
+						IsEnabled: nullable.Value(true),
+						Mode:      pointer.To(stable.PersistentBrowserSessionMode_Never),
+					},
+					SignInFrequency: &stable.SignInFrequencySessionControl{
+						IsEnabled:         nullable.Value(true),
+						FrequencyInterval: pointer.To(stable.SignInFrequencyInterval_TimeBased),
+						Type:              pointer.To(stable.SigninFrequencyType_Days),
+						Value:             nullable.Value(int64(1)),
+					},
+				},
+			},
+		},
+		{
+			ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+				GrantControls: &stable.ConditionalAccessGrantControls{
+					BuiltInControls: pointer.To([]stable.ConditionalAccessGrantControl{stable.ConditionalAccessGrantControl_Mfa}),
+					TermsOfUse:      pointer.To([]string{"00000000-0000-0000-0000-0000000000bb"}),
+				},
+			},
+			SessionControls: &extendedpolicy.ConditionalAccessSessionControls{
+				ConditionalAccessSessionControls: stable.ConditionalAccessSessionControls{
+					PersistentBrowser: &stable.PersistentBrowserSessionControl{
+						IsEnabled: nullable.Value(true),
+						Mode:      pointer.To(stable.PersistentBrowserSessionMode_Always),
+					},
+					SignInFrequency: &stable.SignInFrequencySessionControl{
+						IsEnabled:         nullable.Value(true),
+						FrequencyInterval: pointer.To(stable.SignInFrequencyInterval_TimeBased),
+						Type:              pointer.To(stable.SigninFrequencyType_Hours),
+						Value:             nullable.Value(int64(12)),
+					},
+				},
+			},
+		},
+	}
+
+	grantControls := aggregateConditionalAccessGrantControls(policies)
+	if grantControls.Blocked {
+		t.Fatalf("Expected sign-in not to be blocked")
+	}
+	if expected := []string{"compliantDevice", "mfa"}; !reflect.DeepEqual(grantControls.BuiltInControls, expected) {
+		t.Fatalf("Expected built-in controls %v, got %v", expected, grantControls.BuiltInControls)
+	}
+	if len(grantControls.AuthenticationStrengthPolicyIds) != 0 {
+		t.Fatalf("Expected no authentication strength policies, got %v", grantControls.AuthenticationStrengthPolicyIds)
+	}
+	if expected := []string{"00000000-0000-0000-0000-0000000000bb"}; !reflect.DeepEqual(grantControls.TermsOfUse, expected) {
+		t.Fatalf("Expected terms of use %v, got %v", expected, grantControls.TermsOfUse)
+	}
+	if len(grantControls.Alternatives) != 0 {
+		t.Fatalf("Expected no alternatives, got %v", grantControls.Alternatives)
+	}
+
+	sessionControls := aggregateConditionalAccessSessionControls(policies)[0].(map[string]interface{})
+	if v := sessionControls["persistent_browser_mode"]; v != "never" {
+		t.Fatalf("Expected persistent browser mode %q, got %q", "never", v)
+	}
+	if v := sessionControls["sign_in_frequency"]; v != 12 {
+		t.Fatalf("Expected sign-in frequency %d, got %v", 12, v)
+	}
+	if v := sessionControls["sign_in_frequency_period"]; v != "hours" {
+		t.Fatalf("Expected sign-in frequency period %q, got %q", "hours", v)
+	}
+}
+
+func TestAggregateConditionalAccessGrantControlsOperator(t *testing.T) {
+	policies := []extendedpolicy.ConditionalAccessPolicy{
+		{ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			Id: pointer.To("00000000-0000-0000-0000-0000000000cc"),
+			GrantControls: &stable.ConditionalAccessGrantControls{
+				Operator:        nullable.Value("OR"),
+				BuiltInControls: pointer.To([]stable.ConditionalAccessGrantControl{stable.ConditionalAccessGrantControl_Mfa, stable.ConditionalAccessGrantControl_CompliantDevice}),
+			},
+		}},
+		{ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			GrantControls: &stable.ConditionalAccessGrantControls{
+				Operator:        nullable.Value("OR"),
+				BuiltInControls: pointer.To([]stable.ConditionalAccessGrantControl{stable.ConditionalAccessGrantControl_DomainJoinedDevice}),
+			},
+		}},
+		{ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			GrantControls: &stable.ConditionalAccessGrantControls{
+				Operator:        nullable.Value("AND"),
+				BuiltInControls: pointer.To([]stable.ConditionalAccessGrantControl{stable.ConditionalAccessGrantControl_Mfa, stable.ConditionalAccessGrantControl_PasswordChange}),
+			},
+		}},
+	}
+
+	grantControls := aggregateConditionalAccessGrantControls(policies)
+	if expected := []string{"domainJoinedDevice", "mfa", "passwordChange"}; !reflect.DeepEqual(grantControls.BuiltInControls, expected) {
+		t.Fatalf("Expected built-in controls %v, got %v", expected, grantControls.BuiltInControls)
+	}
+	if len(grantControls.Alternatives) != 1 {
+		t.Fatalf("Expected 1 alternative, got %d", len(grantControls.Alternatives))
+	}
+	alternative := grantControls.Alternatives[0]
+	if expected := "/identity/conditionalAccess/policies/00000000-0000-0000-0000-0000000000cc"; alternative.PolicyId != expected {
+		t.Fatalf("Expected alternative policy ID %q, got %q", expected, alternative.PolicyId)
+	}
+	if expected := []string{"compliantDevice", "mfa"}; !reflect.DeepEqual(alternative.BuiltInControls, expected) {
+		t.Fatalf("Expected alternative built-in controls %v, got %v", expected, alternative.BuiltInControls)
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesstemplate"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
+)
+
+type ConditionalAccessPolicyFromTemplateModel struct {
+	TemplateId  string                                          `tfschema:"template_id"`
+	DisplayName string                                          `tfschema:"display_name"`
+	State       string                                          `tfschema:"state"`
+	Users       []ConditionalAccessPolicyFromTemplateUsersModel `tfschema:"users"`
+
+	PolicyId       string `tfschema:"policy_id"`
+	PolicyObjectId string `tfschema:"policy_object_id"`
+}
+
+type ConditionalAccessPolicyFromTemplateUsersModel struct {
+	IncludedUsers  []string `tfschema:"included_users"`
+	ExcludedUsers  []string `tfschema:"excluded_users"`
+	IncludedGroups []string `tfschema:"included_groups"`
+	ExcludedGroups []string `tfschema:"excluded_groups"`
+	IncludedRoles  []string `tfschema:"included_roles"`
+	ExcludedRoles  []string `tfschema:"excluded_roles"`
+}
+
+var _ sdk.ResourceWithUpdate = ConditionalAccessPolicyFromTemplateResource{}
+
+type ConditionalAccessPolicyFromTemplateResource struct{}
+
+func (r ConditionalAccessPolicyFromTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidatePolicyFromTemplateID
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) ResourceType() string {
+	return "azuread_conditional_access_policy_from_template"
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) ModelObject() interface{} {
+	return &ConditionalAccessPolicyFromTemplateModel{}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	userListSchema := func(description string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Description: description,
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"template_id": {
+			Description:  "The ID of the conditional access template from which to create the policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"display_name": {
+			Description:  "The display name for the policy. Defaults to the name of the template",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"state": {
+			Description:  "The state of the policy. Defaults to `enabledForReportingButNotEnforced`",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(stable.ConditionalAccessPolicyState_EnabledForReportingButNotEnforced),
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConditionalAccessPolicyState(), false),
+		},
+
+		"users": {
+			Description: "Users, groups and roles to include in and exclude from the policy, overriding those specified by the template",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"included_users":  userListSchema("A list of user IDs in scope of the policy, or `All`, `None` or `GuestsOrExternalUsers`"),
+					"excluded_users":  userListSchema("A list of user IDs excluded from scope of the policy, or `GuestsOrExternalUsers`"),
+					"included_groups": userListSchema("A list of group IDs in scope of the policy"),
+					"excluded_groups": userListSchema("A list of group IDs excluded from scope of the policy"),
+					"included_roles":  userListSchema("A list of role template IDs in scope of the policy"),
+					"excluded_roles":  userListSchema("A list of role template IDs excluded from scope of the policy"),
+				},
+			},
+		},
+	}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"policy_id": {
+			Description: "The resource ID for the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"policy_object_id": {
+			Description: "The object ID for the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ConditionalAccess.ExtendedPolicyClient
+			templateClient := metadata.Client.ConditionalAccess.TemplateClient
+
+			var model ConditionalAccessPolicyFromTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			templateId := stable.NewIdentityConditionalAccessTemplateID(model.TemplateId)
+
+			templateResp, err := templateClient.GetConditionalAccessTemplate(ctx, templateId, conditionalaccesstemplate.DefaultGetConditionalAccessTemplateOperationOptions())
+			if err != nil {
+				if response.WasNotFound(templateResp.HttpResponse) {
+					return fmt.Errorf("%s was not found", templateId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", templateId, err)
+			}
+
+			template := templateResp.Model
+			if template == nil {
+				return fmt.Errorf("retrieving %s: model was nil", templateId)
+			}
+			if template.Details == nil || template.Details.Conditions == nil {
+				return fmt.Errorf("retrieving %s: template did not specify any policy conditions", templateId)
+			}
+
+			conditions := extendedpolicy.ConditionalAccessConditionSet{
+				ConditionalAccessConditionSet: *template.Details.Conditions,
+				InsiderRiskLevels:             template.Details.Conditions.InsiderRiskLevels,
+			}
+
+			if len(model.Users) > 0 {
+				conditions.Users = expandConditionalAccessPolicyFromTemplateUsers(model.Users[0], conditions.Users)
+			} else {
+				conditions.Users = conditionalAccessTemplateUsers(conditions.Users)
+			}
+
+			displayName := model.DisplayName
+			if displayName == "" {
+				displayName = pointer.From(template.Name)
+			}
+
+			properties := extendedpolicy.ConditionalAccessPolicy{
+				ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+					DisplayName:   pointer.To(displayName),
+					State:         pointer.To(stable.ConditionalAccessPolicyState(model.State)),
+					GrantControls: conditionalAccessTemplateGrantControls(template.Details.GrantControls),
+				},
+				Conditions: &conditions,
+			}
+
+			if template.Details.SessionControls != nil {
+				properties.SessionControls = &extendedpolicy.ConditionalAccessSessionControls{
+					ConditionalAccessSessionControls: *template.Details.SessionControls,
+				}
+			}
+
+			resp, err := client.CreateConditionalAccessPolicy(ctx, properties)
+			if err != nil {
+				return fmt.Errorf("creating conditional access policy from %s: %+v", templateId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("creating conditional access policy from %s: model was nil", templateId)
+			}
+			if resp.Model.Id == nil || *resp.Model.Id == "" {
+				return fmt.Errorf("creating conditional access policy from %s: object ID returned for policy is nil/empty", templateId)
+			}
+
+			id := parse.NewPolicyFromTemplateID(model.TemplateId, *resp.Model.Id)
+			metadata.SetID(id)
+
+			if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetConditionalAccessPolicy(ctx, stable.NewIdentityConditionalAccessPolicyID(id.PolicyId))
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(resp.Model != nil), nil
+			}); err != nil {
+				return fmt.Errorf("creating conditional access policy from %s: timed out waiting for replication of new policy", templateId)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ConditionalAccess.ExtendedPolicyClient
+
+			id, err := parse.ParsePolicyFromTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			policyId := stable.NewIdentityConditionalAccessPolicyID(id.PolicyId)
+
+			resp, err := client.GetConditionalAccessPolicy(ctx, policyId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", policyId)
+			}
+
+			state := ConditionalAccessPolicyFromTemplateModel{
+				TemplateId:     id.TemplateId,
+				DisplayName:    pointer.From(policy.DisplayName),
+				State:          string(pointer.From(policy.State)),
+				Users:          []ConditionalAccessPolicyFromTemplateUsersModel{},
+				PolicyId:       policyId.ID(),
+				PolicyObjectId: id.PolicyId,
+			}
+
+			if policy.Conditions != nil && policy.Conditions.Users != nil {
+				users := policy.Conditions.Users
+				state.Users = []ConditionalAccessPolicyFromTemplateUsersModel{{
+					IncludedUsers:  pointer.From(users.IncludeUsers),
+					ExcludedUsers:  pointer.From(users.ExcludeUsers),
+					IncludedGroups: pointer.From(users.IncludeGroups),
+					ExcludedGroups: pointer.From(users.ExcludeGroups),
+					IncludedRoles:  pointer.From(users.IncludeRoles),
+					ExcludedRoles:  pointer.From(users.ExcludeRoles),
+				}}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ConditionalAccess.ExtendedPolicyClient
+			rd := metadata.ResourceData
+
+			id, err := parse.ParsePolicyFromTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ConditionalAccessPolicyFromTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policyId := stable.NewIdentityConditionalAccessPolicyID(id.PolicyId)
+
+			// Omitted controls are sent as null and would be removed from the policy, so the existing policy is retrieved
+			// and sent in its entirety, in order to preserve the controls and conditions specified by the template
+			resp, err := client.GetConditionalAccessPolicy(ctx, policyId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			existing := resp.Model
+			if existing == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			properties := extendedpolicy.ConditionalAccessPolicy{
+				ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+					DisplayName:   existing.DisplayName,
+					State:         existing.State,
+					GrantControls: conditionalAccessTemplateGrantControls(existing.GrantControls),
+				},
+				Conditions:      existing.Conditions,
+				SessionControls: existing.SessionControls,
+			}
+
+			if rd.HasChange("display_name") {
+				properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if rd.HasChange("state") {
+				properties.State = pointer.To(stable.ConditionalAccessPolicyState(model.State))
+			}
+
+			if rd.HasChange("users") && len(model.Users) > 0 && properties.Conditions != nil {
+				properties.Conditions.Users = expandConditionalAccessPolicyFromTemplateUsers(model.Users[0], properties.Conditions.Users)
+			}
+
+			if _, err = client.UpdateConditionalAccessPolicy(ctx, policyId, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ConditionalAccess.PolicyClient
+
+			id, err := parse.ParsePolicyFromTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			policyId := stable.NewIdentityConditionalAccessPolicyID(id.PolicyId)
+
+			if _, err = client.DeleteConditionalAccessPolicy(ctx, policyId, conditionalaccesspolicy.DefaultDeleteConditionalAccessPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+				if resp, err := client.GetConditionalAccessPolicy(ctx, policyId, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions()); err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(true), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandConditionalAccessPolicyFromTemplateUsers overrides the users, groups and roles of the provided users condition,
+// whilst preserving any guest or external user conditions specified by the template
+func expandConditionalAccessPolicyFromTemplateUsers(in ConditionalAccessPolicyFromTemplateUsersModel, existing *stable.ConditionalAccessUsers) *stable.ConditionalAccessUsers {
+	result := stable.ConditionalAccessUsers{}
+	if existing != nil {
+		result.IncludeGuestsOrExternalUsers = existing.IncludeGuestsOrExternalUsers
+		result.ExcludeGuestsOrExternalUsers = existing.ExcludeGuestsOrExternalUsers
+	}
+
+	result.IncludeUsers = pointer.To(in.IncludedUsers)
+	result.ExcludeUsers = pointer.To(in.ExcludedUsers)
+	result.IncludeGroups = pointer.To(in.IncludedGroups)
+	result.ExcludeGroups = pointer.To(in.ExcludedGroups)
+	result.IncludeRoles = pointer.To(in.IncludedRoles)
+	result.ExcludeRoles = pointer.To(in.ExcludedRoles)
+
+	return &result
+}
+
+// conditionalAccessTemplateUsers removes placeholder values from the users condition of a template. Templates can
+// include descriptive values, such as "Current administrator will be excluded", which are not accepted when creating a
+// policy, so only object IDs and the well-known values supported by the API are retained.
+func conditionalAccessTemplateUsers(in *stable.ConditionalAccessUsers) *stable.ConditionalAccessUsers {
+	if in == nil {
+		return nil
+	}
+
+	filter := func(values *[]string) *[]string {
+		result := make([]string, 0)
+		for _, v := range pointer.From(values) {
+			if _, errs := validation.IsUUID(v, "value"); len(errs) == 0 || conditionalAccessContains([]string{conditionalAccessAll, conditionalAccessGuestsOrExternalUsers, "None"}, strings.TrimSpace(v)) {
+				result = append(result, v)
+			}
+		}
+		return &result
+	}
+
+	result := *in
+	result.IncludeUsers = filter(in.IncludeUsers)
+	result.ExcludeUsers = filter(in.ExcludeUsers)
+	result.IncludeGroups = filter(in.IncludeGroups)
+	result.ExcludeGroups = filter(in.ExcludeGroups)
+	result.IncludeRoles = filter(in.IncludeRoles)
+	result.ExcludeRoles = filter(in.ExcludeRoles)
+
+	return &result
+}
+
+// conditionalAccessTemplateGrantControls returns the provided grant controls, retaining only the ID of any authentication
+// strength policy, since the full authentication strength policy is returned by the API but cannot be sent
+func conditionalAccessTemplateGrantControls(in *stable.ConditionalAccessGrantControls) *stable.ConditionalAccessGrantControls {
+	if in == nil || in.AuthenticationStrength == nil {
+		return in
+	}
+
+	result := *in
+	result.AuthenticationStrength = &stable.AuthenticationStrengthPolicy{
+		Id: in.AuthenticationStrength.Id,
+	}
+
+	return &result
+}
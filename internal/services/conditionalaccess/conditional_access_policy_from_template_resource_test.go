@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/parse"
+)
+
+type ConditionalAccessPolicyFromTemplateResource struct{}
+
+func TestAccConditionalAccessPolicyFromTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy_from_template", "test")
+	r := ConditionalAccessPolicyFromTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabledForReportingButNotEnforced"),
+				check.That(data.ResourceName).Key("policy_id").Exists(),
+				check.That(data.ResourceName).Key("policy_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("users.0.included_roles.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessPolicyFromTemplate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy_from_template", "test")
+	r := ConditionalAccessPolicyFromTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-CONPOLICY-%d-updated", data.RandomInteger)),
+				check.That(data.ResourceName).Key("state").HasValue("disabled"),
+				check.That(data.ResourceName).Key("users.0.excluded_users.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ParsePolicyFromTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ConditionalAccess.PolicyClient.GetConditionalAccessPolicy(ctx, stable.NewIdentityConditionalAccessPolicyID(id.PolicyId), conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ConditionalAccessPolicyFromTemplateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_conditional_access_policy_from_template" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  template_id  = "%[2]s"
+}
+`, data.RandomInteger, testConditionalAccessTemplateId)
+}
+
+func (ConditionalAccessPolicyFromTemplateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[3]s"
+}
+
+resource "azuread_conditional_access_policy_from_template" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d-updated"
+  template_id  = "%[2]s"
+  state        = "disabled"
+
+  users {
+    included_roles = ["62e90394-69f5-4237-9190-012177145e10"]
+    excluded_users = [azuread_user.test.object_id]
+  }
+}
+`, data.RandomInteger, testConditionalAccessTemplateId, data.RandomPassword)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/migrations"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
 )
 
 func conditionalAccessPolicyResource() *pluginsdk.Resource {
@@ -86,7 +88,7 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 									"included_applications": {
 										Type:         pluginsdk.TypeList,
 										Optional:     true,
-										ExactlyOneOf: []string{"conditions.0.applications.0.included_applications", "conditions.0.applications.0.included_user_actions"},
+										ExactlyOneOf: []string{"conditions.0.applications.0.included_applications", "conditions.0.applications.0.included_authentication_context_class_references", "conditions.0.applications.0.included_user_actions"},
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
 											ValidateFunc: validation.StringIsNotEmpty,
@@ -102,15 +104,46 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 										},
 									},
 
+									"included_authentication_context_class_references": {
+										Type:         pluginsdk.TypeList,
+										Optional:     true,
+										ExactlyOneOf: []string{"conditions.0.applications.0.included_applications", "conditions.0.applications.0.included_authentication_context_class_references", "conditions.0.applications.0.included_user_actions"},
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
 									"included_user_actions": {
 										Type:         pluginsdk.TypeList,
 										Optional:     true,
-										ExactlyOneOf: []string{"conditions.0.applications.0.included_applications", "conditions.0.applications.0.included_user_actions"},
+										ExactlyOneOf: []string{"conditions.0.applications.0.included_applications", "conditions.0.applications.0.included_authentication_context_class_references", "conditions.0.applications.0.included_user_actions"},
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
 											ValidateFunc: validation.StringIsNotEmpty,
 										},
 									},
+
+									"application_filter": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"mode": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(stable.PossibleValuesForFilterMode(), false),
+												},
+
+												"rule": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -138,6 +171,27 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 											ValidateFunc: validation.StringIsNotEmpty,
 										},
 									},
+
+									"service_principal_filter": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"mode": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(stable.PossibleValuesForFilterMode(), false),
+												},
+
+												"rule": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -289,6 +343,27 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 							},
 						},
 
+						"authentication_flows": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"transfer_methods": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(extendedpolicy.ConditionalAccessTransferMethods_AuthenticationTransfer),
+												string(extendedpolicy.ConditionalAccessTransferMethods_DeviceCodeFlow),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
 						"client_app_types": {
 							Type:     pluginsdk.TypeList,
 							Required: true,
@@ -328,6 +403,15 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 							},
 						},
 
+						"insider_risk_levels": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConditionalAccessInsiderRiskLevels(), false),
+							},
+						},
+
 						"locations": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -483,6 +567,12 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForCloudAppSecuritySessionControlType(), false),
 						},
 
+						"continuous_access_evaluation_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(beta.PossibleValuesForContinuousAccessEvaluationMode(), false),
+						},
+
 						"disable_resilience_defaults": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -494,6 +584,11 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForPersistentBrowserSessionMode(), false),
 						},
 
+						"secure_sign_in_session_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
 						"sign_in_frequency": {
 							Type:         pluginsdk.TypeInt,
 							Optional:     true,
@@ -543,13 +638,28 @@ func conditionalAccessPolicyCustomizeDiff(_ context.Context, diff *pluginsdk.Res
 		diff.Get("session_controls.0.cloud_app_security_policy").(string) == "" && !diff.Get("session_controls.0.disable_resilience_defaults").(bool) &&
 		diff.Get("session_controls.0.persistent_browser_mode").(string) == "" && diff.Get("session_controls.0.sign_in_frequency").(int) == 0 &&
 		diff.Get("session_controls.0.sign_in_frequency_authentication_type").(string) == string(stable.SignInFrequencyAuthenticationType_PrimaryAndSecondaryAuthentication) &&
-		diff.Get("session_controls.0.sign_in_frequency_interval").(string) == string(stable.SignInFrequencyInterval_TimeBased) {
+		diff.Get("session_controls.0.sign_in_frequency_interval").(string) == string(stable.SignInFrequencyInterval_TimeBased) &&
+		diff.Get("session_controls.0.continuous_access_evaluation_mode").(string) == "" && !diff.Get("session_controls.0.secure_sign_in_session_enabled").(bool) {
 		sessionControlsSetButIneffective = true
 	}
 	if diff.Get("grant_controls.#").(int) == 0 && sessionControlsSetButIneffective {
 		return fmt.Errorf("when specifying `session_controls` but not `grant_controls`, one of the properties in the `session_controls` block must be set to an effective value in order for session controls to work")
 	}
 
+	// Once a policy has been written with the beta API, it can no longer be managed with the v1.0 API, so removing the
+	// session controls that are only available in the beta API requires the policy to be recreated
+	for _, key := range []string{"session_controls.0.continuous_access_evaluation_mode", "session_controls.0.secure_sign_in_session_enabled"} {
+		if diff.Id() == "" || !diff.HasChange(key) {
+			continue
+		}
+		if old, _ := diff.GetChange("session_controls"); conditionalAccessPolicySessionControlsUseBeta(old.([]interface{})) &&
+			!conditionalAccessPolicySessionControlsUseBeta(diff.Get("session_controls").([]interface{})) {
+			if err := diff.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -571,12 +681,18 @@ func conditionalAccessPolicyDiffSuppress(k, old, new string, d *pluginsdk.Resour
 			if v, ok := sessionControls["cloud_app_security_policy"]; ok && v.(string) != "" {
 				suppress = false
 			}
+			if v, ok := sessionControls["continuous_access_evaluation_mode"]; ok && v.(string) != "" {
+				suppress = false
+			}
 			if v, ok := sessionControls["disable_resilience_defaults"]; ok && v.(bool) {
 				suppress = false
 			}
 			if v, ok := sessionControls["persistent_browser_mode"]; ok && v.(string) != "" {
 				suppress = false
 			}
+			if v, ok := sessionControls["secure_sign_in_session_enabled"]; ok && v.(bool) {
+				suppress = false
+			}
 			if v, ok := sessionControls["sign_in_frequency"]; ok && v.(int) > 0 {
 				suppress = false
 			}
@@ -595,8 +711,30 @@ func conditionalAccessPolicyDiffSuppress(k, old, new string, d *pluginsdk.Resour
 	return suppress
 }
 
+// conditionalAccessPolicySessionControlsUseBeta returns whether the provided `session_controls` specify any session
+// controls which are only available in the beta API
+func conditionalAccessPolicySessionControlsUseBeta(in []interface{}) bool {
+	if len(in) == 0 || in[0] == nil {
+		return false
+	}
+
+	sessionControls := in[0].(map[string]interface{})
+
+	return sessionControls["continuous_access_evaluation_mode"].(string) != "" || sessionControls["secure_sign_in_session_enabled"].(bool)
+}
+
+// conditionalAccessPolicyClient returns the client with which a policy should be managed. Policies that specify session
+// controls which are only available in the beta API are written and read with the beta API, since a policy written with
+// the beta API can no longer be read with the v1.0 API.
+func conditionalAccessPolicyClient(d *pluginsdk.ResourceData, meta interface{}) *extendedpolicy.PolicyClient {
+	if conditionalAccessPolicySessionControlsUseBeta(d.Get("session_controls").([]interface{})) {
+		return meta.(*clients.Client).ConditionalAccess.ExtendedPolicyBetaClient
+	}
+	return meta.(*clients.Client).ConditionalAccess.ExtendedPolicyClient
+}
+
 func conditionalAccessPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.PolicyClient
+	client := conditionalAccessPolicyClient(d, meta)
 
 	var err error
 
@@ -608,20 +746,22 @@ func conditionalAccessPolicyResourceCreate(ctx context.Context, d *pluginsdk.Res
 		}
 	}
 
-	var sessionControls *stable.ConditionalAccessSessionControls
+	var sessionControls *extendedpolicy.ConditionalAccessSessionControls
 	if v, ok := d.GetOk("session_controls"); ok {
 		sessionControls = expandConditionalAccessSessionControls(v.([]interface{}))
 	}
 
-	properties := stable.ConditionalAccessPolicy{
-		DisplayName:     pointer.To(d.Get("display_name").(string)),
-		State:           pointer.To(stable.ConditionalAccessPolicyState(d.Get("state").(string))),
+	properties := extendedpolicy.ConditionalAccessPolicy{
+		ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			DisplayName:   pointer.To(d.Get("display_name").(string)),
+			State:         pointer.To(stable.ConditionalAccessPolicyState(d.Get("state").(string))),
+			GrantControls: grantControls,
+		},
 		Conditions:      expandConditionalAccessConditionSet(d.Get("conditions").([]interface{})),
-		GrantControls:   grantControls,
 		SessionControls: sessionControls,
 	}
 
-	resp, err := client.CreateConditionalAccessPolicy(ctx, properties, conditionalaccesspolicy.DefaultCreateConditionalAccessPolicyOperationOptions())
+	resp, err := client.CreateConditionalAccessPolicy(ctx, properties)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not create conditional access policy")
 	}
@@ -639,7 +779,7 @@ func conditionalAccessPolicyResourceCreate(ctx context.Context, d *pluginsdk.Res
 
 	// Consistency check
 	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
-		resp, err := client.GetConditionalAccessPolicy(ctx, id, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions())
+		resp, err := client.GetConditionalAccessPolicy(ctx, id)
 		if err != nil {
 			if response.WasNotFound(resp.HttpResponse) {
 				return pointer.To(false), nil
@@ -657,7 +797,7 @@ func conditionalAccessPolicyResourceCreate(ctx context.Context, d *pluginsdk.Res
 }
 
 func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.PolicyClient
+	client := conditionalAccessPolicyClient(d, meta)
 
 	id, err := stable.ParseIdentityConditionalAccessPolicyID(d.Id())
 	if err != nil {
@@ -672,20 +812,22 @@ func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *pluginsdk.Res
 		}
 	}
 
-	var sessionControls *stable.ConditionalAccessSessionControls
+	var sessionControls *extendedpolicy.ConditionalAccessSessionControls
 	if v, ok := d.GetOk("session_controls"); ok {
 		sessionControls = expandConditionalAccessSessionControls(v.([]interface{}))
 	}
 
-	properties := stable.ConditionalAccessPolicy{
-		DisplayName:     pointer.To(d.Get("display_name").(string)),
-		State:           pointer.To(stable.ConditionalAccessPolicyState(d.Get("state").(string))),
+	properties := extendedpolicy.ConditionalAccessPolicy{
+		ConditionalAccessPolicy: stable.ConditionalAccessPolicy{
+			DisplayName:   pointer.To(d.Get("display_name").(string)),
+			State:         pointer.To(stable.ConditionalAccessPolicyState(d.Get("state").(string))),
+			GrantControls: grantControls,
+		},
 		Conditions:      expandConditionalAccessConditionSet(d.Get("conditions").([]interface{})),
-		GrantControls:   grantControls,
 		SessionControls: sessionControls,
 	}
 
-	if _, err := client.UpdateConditionalAccessPolicy(ctx, *id, properties, conditionalaccesspolicy.DefaultUpdateConditionalAccessPolicyOperationOptions()); err != nil {
+	if _, err := client.UpdateConditionalAccessPolicy(ctx, *id, properties); err != nil {
 		return tf.ErrorDiagF(err, "Could not update conditional access policy with ID: %q", d.Id())
 	}
 
@@ -700,7 +842,7 @@ func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *pluginsdk.Res
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Refresh: func() (interface{}, string, error) {
-			resp, err := client.GetConditionalAccessPolicy(ctx, *id, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions())
+			resp, err := client.GetConditionalAccessPolicy(ctx, *id)
 			if err != nil {
 				return nil, "Error", err
 			}
@@ -727,14 +869,21 @@ func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *pluginsdk.Res
 }
 
 func conditionalAccessPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.PolicyClient
+	client := conditionalAccessPolicyClient(d, meta)
 
 	id, err := stable.ParseIdentityConditionalAccessPolicyID(d.Id())
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "id", "Parsing Conditional Access Policy ID")
 	}
 
-	resp, err := client.GetConditionalAccessPolicy(ctx, *id, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions())
+	resp, err := client.GetConditionalAccessPolicy(ctx, *id)
+	if err != nil && !response.WasNotFound(resp.HttpResponse) && client != meta.(*clients.Client).ConditionalAccess.ExtendedPolicyBetaClient {
+		// The policy may have been written with the beta API outside of Terraform (e.g. when importing), in which case
+		// it can only be read with the beta API
+		log.Printf("[DEBUG] Retrieving %s with the v1.0 API failed, retrying with the beta API: %v", id, err)
+		client = meta.(*clients.Client).ConditionalAccess.ExtendedPolicyBetaClient
+		resp, err = client.GetConditionalAccessPolicy(ctx, *id)
+	}
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s not found - removing from state", id)
@@ -755,20 +904,21 @@ func conditionalAccessPolicyResourceRead(ctx context.Context, d *pluginsdk.Resou
 	tf.Set(d, "state", pointer.From(policy.State))
 	tf.Set(d, "conditions", flattenConditionalAccessConditionSet(policy.Conditions))
 	tf.Set(d, "grant_controls", flattenConditionalAccessGrantControls(policy.GrantControls))
-	tf.Set(d, "session_controls", flattenConditionalAccessSessionControls(policy.SessionControls))
+	tf.Set(d, "session_controls", flattenConditionalAccessPolicySessionControls(policy.SessionControls))
 
 	return nil
 }
 
 func conditionalAccessPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).ConditionalAccess.PolicyClient
+	extendedClient := conditionalAccessPolicyClient(d, meta)
 
 	id, err := stable.ParseIdentityConditionalAccessPolicyID(d.Id())
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "id", "Parsing Conditional Access Policy ID")
 	}
 
-	resp, err := client.GetConditionalAccessPolicy(ctx, *id, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions())
+	resp, err := extendedClient.GetConditionalAccessPolicy(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s already deleted", id)
@@ -783,7 +933,7 @@ func conditionalAccessPolicyResourceDelete(ctx context.Context, d *pluginsdk.Res
 	}
 
 	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
-		if resp, err := client.GetConditionalAccessPolicy(ctx, *id, conditionalaccesspolicy.DefaultGetConditionalAccessPolicyOperationOptions()); err != nil {
+		if resp, err := extendedClient.GetConditionalAccessPolicy(ctx, *id); err != nil {
 			if response.WasNotFound(resp.HttpResponse) {
 				return pointer.To(false), nil
 			}
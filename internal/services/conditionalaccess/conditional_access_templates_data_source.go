@@ -0,0 +1,615 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesstemplate"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
+)
+
+func conditionalAccessTemplatesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: conditionalAccessTemplatesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scenario": {
+				Description:  "Only return templates which are recommended for this scenario",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForTemplateScenarios(), false),
+			},
+
+			"template_ids": {
+				Description: "The IDs of the conditional access templates",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"templates": {
+				Description: "A list of conditional access templates",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"template_id": {
+							Description: "The ID of the template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"name": {
+							Description: "The name of the template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "The description of the template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"scenarios": {
+							Description: "The scenarios for which the template is recommended",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"conditions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"applications": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"included_applications": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_applications": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"included_authentication_context_class_references": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"included_user_actions": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"application_filter": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"mode": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+
+															"rule": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"client_applications": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"included_service_principals": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_service_principals": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"service_principal_filter": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"mode": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+
+															"rule": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"users": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"included_users": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_users": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"included_groups": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_groups": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"included_roles": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_roles": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"included_guests_or_external_users": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"guest_or_external_user_types": {
+																Type:     pluginsdk.TypeList,
+																Computed: true,
+																Elem: &pluginsdk.Schema{
+																	Type: pluginsdk.TypeString,
+																},
+															},
+
+															"external_tenants": {
+																Type:     pluginsdk.TypeList,
+																Computed: true,
+																Elem: &pluginsdk.Resource{
+																	Schema: map[string]*pluginsdk.Schema{
+																		"membership_kind": {
+																			Type:     pluginsdk.TypeString,
+																			Computed: true,
+																		},
+
+																		"members": {
+																			Type:     pluginsdk.TypeList,
+																			Computed: true,
+																			Elem: &pluginsdk.Schema{
+																				Type: pluginsdk.TypeString,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
+												"excluded_guests_or_external_users": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"guest_or_external_user_types": {
+																Type:     pluginsdk.TypeList,
+																Computed: true,
+																Elem: &pluginsdk.Schema{
+																	Type: pluginsdk.TypeString,
+																},
+															},
+
+															"external_tenants": {
+																Type:     pluginsdk.TypeList,
+																Computed: true,
+																Elem: &pluginsdk.Resource{
+																	Schema: map[string]*pluginsdk.Schema{
+																		"membership_kind": {
+																			Type:     pluginsdk.TypeString,
+																			Computed: true,
+																		},
+
+																		"members": {
+																			Type:     pluginsdk.TypeList,
+																			Computed: true,
+																			Elem: &pluginsdk.Schema{
+																				Type: pluginsdk.TypeString,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"authentication_flows": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"transfer_methods": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+											},
+										},
+									},
+
+									"client_app_types": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"devices": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"filter": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"mode": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+
+															"rule": {
+																Type:     pluginsdk.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"insider_risk_levels": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"locations": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"included_locations": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_locations": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+											},
+										},
+									},
+
+									"platforms": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"included_platforms": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"excluded_platforms": {
+													Type:     pluginsdk.TypeList,
+													Computed: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+											},
+										},
+									},
+
+									"service_principal_risk_levels": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"sign_in_risk_levels": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"user_risk_levels": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"grant_controls": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"built_in_controls": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"authentication_strength_policy_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"custom_authentication_factors": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"terms_of_use": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"session_controls": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"application_enforced_restrictions_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"cloud_app_security_policy": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"disable_resilience_defaults": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"persistent_browser_mode": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"sign_in_frequency_authentication_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency_interval": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency_period": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func conditionalAccessTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.TemplateClient
+
+	resp, err := client.ListConditionalAccessTemplates(ctx, conditionalaccesstemplate.DefaultListConditionalAccessTemplatesOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing conditional access templates")
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Listing conditional access templates")
+	}
+
+	scenario := d.Get("scenario").(string)
+
+	templateIds := make([]string, 0)
+	templates := make([]interface{}, 0)
+
+	for _, template := range *resp.Model {
+		if template.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned conditional access template with nil ID"), "Bad API Response")
+		}
+
+		scenarios := make([]string, 0)
+		for _, v := range strings.Split(string(pointer.From(template.Scenarios)), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				scenarios = append(scenarios, v)
+			}
+		}
+
+		if scenario != "" && !conditionalAccessContains(scenarios, scenario) {
+			continue
+		}
+
+		conditions := make([]interface{}, 0)
+		grantControls := make([]interface{}, 0)
+		sessionControls := make([]interface{}, 0)
+		if details := template.Details; details != nil {
+			if details.Conditions != nil {
+				conditions = flattenConditionalAccessConditionSet(&extendedpolicy.ConditionalAccessConditionSet{
+					ConditionalAccessConditionSet: *details.Conditions,
+					InsiderRiskLevels:             details.Conditions.InsiderRiskLevels,
+				})
+			}
+			grantControls = flattenConditionalAccessGrantControls(details.GrantControls)
+			sessionControls = flattenConditionalAccessSessionControls(details.SessionControls)
+		}
+
+		templateIds = append(templateIds, *template.Id)
+		templates = append(templates, map[string]interface{}{
+			"template_id":      *template.Id,
+			"name":             pointer.From(template.Name),
+			"description":      pointer.From(template.Description),
+			"scenarios":        scenarios,
+			"conditions":       conditions,
+			"grant_controls":   grantControls,
+			"session_controls": sessionControls,
+		})
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(scenario + "/" + strings.Join(templateIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for template IDs")
+	}
+
+	d.SetId("templates#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "template_ids", templateIds)
+	tf.Set(d, "templates", templates)
+
+	return nil
+}
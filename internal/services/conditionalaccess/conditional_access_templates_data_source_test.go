@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+const testConditionalAccessTemplateId = "c7503427-338e-4c5e-902d-abe252abfb43" // Require multifactor authentication for admins
+
+type ConditionalAccessTemplatesDataSource struct{}
+
+func TestAccConditionalAccessTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_templates", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ConditionalAccessTemplatesDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_ids.#").Exists(),
+				check.That(data.ResourceName).Key("templates.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.name").Exists(),
+				check.That(data.ResourceName).Key("templates.0.conditions.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccConditionalAccessTemplatesDataSource_scenario(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_templates", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ConditionalAccessTemplatesDataSource{}.scenario(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("templates.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.scenarios.#").Exists(),
+			),
+		},
+	})
+}
+
+func (ConditionalAccessTemplatesDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_conditional_access_templates" "test" {}
+`
+}
+
+func (ConditionalAccessTemplatesDataSource) scenario() string {
+	return `
+provider "azuread" {}
+
+data "azuread_conditional_access_templates" "test" {
+  scenario = "protectAdmins"
+}
+`
+}
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
+)
+
+func conditionalAccessWhatIfDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: conditionalAccessWhatIfDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"policy_ids": {
+				Description: "The IDs of the conditional access policies to evaluate. When omitted, all policies are evaluated",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: stable.ValidateIdentityConditionalAccessPolicyID,
+				},
+			},
+
+			"sign_in": {
+				Description: "The sign-in to evaluate the policies against",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"user_object_id": {
+							Description:  "The object ID of the signing-in user",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"group_object_ids": {
+							Description: "The object IDs of the groups that the user is a member of, including transitive memberships",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+
+						"role_template_ids": {
+							Description: "The template IDs of the directory roles that are assigned to the user",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+
+						"guest_or_external_user_type": {
+							Description:  "The type of guest or external user, when the user is not a member of the tenant",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConditionalAccessGuestOrExternalUserTypes(), false),
+						},
+
+						"external_tenant_id": {
+							Description:  "The tenant ID of the guest or external user's home tenant",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"sign_in.0.guest_or_external_user_type"},
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"application_id": {
+							Description:  "The client ID of the application being signed in to",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"sign_in.0.application_id", "sign_in.0.authentication_context_class_reference", "sign_in.0.user_action"},
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"authentication_context_class_reference": {
+							Description:  "The ID of the authentication context being requested",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"sign_in.0.application_id", "sign_in.0.authentication_context_class_reference", "sign_in.0.user_action"},
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"user_action": {
+							Description:  "The user action being performed",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"sign_in.0.application_id", "sign_in.0.authentication_context_class_reference", "sign_in.0.user_action"},
+							ValidateFunc: validation.StringInSlice([]string{"urn:user:registerdevice", "urn:user:registersecurityinfo"}, false),
+						},
+
+						"authentication_flow": {
+							Description: "The authentication flow used to sign in, when the sign-in uses a transfer method such as device code flow",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(extendedpolicy.ConditionalAccessTransferMethods_AuthenticationTransfer),
+								string(extendedpolicy.ConditionalAccessTransferMethods_DeviceCodeFlow),
+							}, false),
+						},
+
+						"client_app_type": {
+							Description: "The type of client application used to sign in",
+							Type:        pluginsdk.TypeString,
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(stable.ConditionalAccessClientApp_Browser),
+								string(stable.ConditionalAccessClientApp_EasSupported),
+								string(stable.ConditionalAccessClientApp_ExchangeActiveSync),
+								string(stable.ConditionalAccessClientApp_MobileAppsAndDesktopClients),
+								string(stable.ConditionalAccessClientApp_Other),
+							}, false),
+						},
+
+						"device_platform": {
+							Description: "The platform of the device used to sign in",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(stable.ConditionalAccessDevicePlatform_Android),
+								string(stable.ConditionalAccessDevicePlatform_IOS),
+								string(stable.ConditionalAccessDevicePlatform_Linux),
+								string(stable.ConditionalAccessDevicePlatform_MacOS),
+								string(stable.ConditionalAccessDevicePlatform_Windows),
+								string(stable.ConditionalAccessDevicePlatform_WindowsPhone),
+							}, false),
+						},
+
+						"location_id": {
+							Description:  "The ID of the named location from which the sign-in originates",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"trusted_location": {
+							Description: "Whether the sign-in originates from a trusted location",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"insider_risk_level": {
+							Description:  "The insider risk level of the user, as determined by Adaptive Protection",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConditionalAccessInsiderRiskLevels(), false),
+						},
+
+						"sign_in_risk_level": {
+							Description:  "The risk level of the sign-in",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.RiskLevel_None),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForRiskLevel(), false),
+						},
+
+						"user_risk_level": {
+							Description:  "The risk level of the user",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.RiskLevel_None),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForRiskLevel(), false),
+						},
+					},
+				},
+			},
+
+			"policies": {
+				Description: "The results of evaluating each policy",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"state": {
+							Description: "The state of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"applies": {
+							Description: "Whether the policy applies to the sign-in",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"not_applicable_reasons": {
+							Description: "The reasons why the policy does not apply to the sign-in",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"unevaluated_conditions": {
+							Description: "Conditions of the policy which cannot be evaluated ahead of time, and which were ignored",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"grant_controls": {
+							Description: "The grant controls of the policy",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"built_in_controls": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"authentication_strength_policy_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"custom_authentication_factors": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"terms_of_use": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"session_controls": {
+							Description: "The session controls of the policy",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"application_enforced_restrictions_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"cloud_app_security_policy": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"disable_resilience_defaults": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"persistent_browser_mode": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"sign_in_frequency_authentication_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency_interval": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"sign_in_frequency_period": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"applicable_policy_ids": {
+				Description: "The IDs of the enabled policies which apply to the sign-in",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"partially_evaluated_policy_ids": {
+				Description: "The IDs of the enabled policies which apply to the sign-in, except for conditions which could not be evaluated",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"report_only_policy_ids": {
+				Description: "The IDs of the report-only policies which would apply to the sign-in if they were enabled",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"blocked": {
+				Description: "Whether the sign-in would be blocked by an applicable policy",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"built_in_controls": {
+				Description: "The built-in grant controls required by the applicable policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"authentication_strength_policy_ids": {
+				Description: "The IDs of the authentication strength policies required by the applicable policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"terms_of_use": {
+				Description: "The IDs of the terms of use agreements required by the applicable policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"grant_control_alternatives": {
+				Description: "The grant controls of applicable policies using the `OR` operator, any one of which satisfies the policy",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"policy_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"built_in_controls": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"authentication_strength_policy_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"terms_of_use": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"session_controls": {
+				Description: "The most restrictive session controls enforced by the applicable policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"application_enforced_restrictions_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"cloud_app_security_policy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"persistent_browser_mode": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sign_in_frequency": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"sign_in_frequency_interval": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sign_in_frequency_period": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func conditionalAccessWhatIfDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.ExtendedPolicyClient
+
+	policies := make([]extendedpolicy.ConditionalAccessPolicy, 0)
+
+	if v, ok := d.GetOk("policy_ids"); ok {
+		for i, policyId := range tf.ExpandStringSlice(v.([]interface{})) {
+			id, err := stable.ParseIdentityConditionalAccessPolicyID(policyId)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "policy_ids", "Parsing Conditional Access Policy ID %q", policyId)
+			}
+
+			resp, err := client.GetConditionalAccessPolicy(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return tf.ErrorDiagPathF(nil, "policy_ids", "%s (at index %d) was not found", id, i)
+				}
+				return tf.ErrorDiagF(err, "Retrieving %s", id)
+			}
+			if resp.Model == nil {
+				return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+			}
+
+			policies = append(policies, *resp.Model)
+		}
+	} else {
+		resp, err := client.ListConditionalAccessPolicies(ctx)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing conditional access policies")
+		}
+		if resp.Model != nil {
+			policies = append(policies, *resp.Model...)
+		}
+
+		// Sort the policies so that the results are consistent between reads
+		sort.SliceStable(policies, func(i, j int) bool {
+			return strings.ToLower(pointer.From(policies[i].DisplayName)) < strings.ToLower(pointer.From(policies[j].DisplayName))
+		})
+	}
+
+	signIn := expandConditionalAccessSignIn(d.Get("sign_in").([]interface{}))
+
+	policyIds := make([]string, 0)
+	applicablePolicyIds := make([]string, 0)
+	partiallyEvaluatedPolicyIds := make([]string, 0)
+	reportOnlyPolicyIds := make([]string, 0)
+	applicablePolicies := make([]extendedpolicy.ConditionalAccessPolicy, 0)
+	results := make([]interface{}, 0)
+
+	for _, policy := range policies {
+		if policy.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned conditional access policy with nil object ID"), "Bad API Response")
+		}
+
+		id := stable.NewIdentityConditionalAccessPolicyID(*policy.Id)
+		policyIds = append(policyIds, id.ID())
+
+		evaluation := evaluateConditionalAccessPolicy(policy, signIn)
+		if evaluation.Applies {
+			if pointer.From(policy.State) == stable.ConditionalAccessPolicyState_EnabledForReportingButNotEnforced {
+				reportOnlyPolicyIds = append(reportOnlyPolicyIds, id.ID())
+			} else if len(evaluation.UnevaluatedConditions) > 0 {
+				// Whether the policy applies is not known, so it does not contribute to the aggregated controls
+				partiallyEvaluatedPolicyIds = append(partiallyEvaluatedPolicyIds, id.ID())
+			} else {
+				applicablePolicyIds = append(applicablePolicyIds, id.ID())
+				applicablePolicies = append(applicablePolicies, policy)
+			}
+		}
+
+		var sessionControls *stable.ConditionalAccessSessionControls
+		if policy.SessionControls != nil {
+			sessionControls = &policy.SessionControls.ConditionalAccessSessionControls
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                     id.ID(),
+			"object_id":              id.ConditionalAccessPolicyId,
+			"display_name":           pointer.From(policy.DisplayName),
+			"state":                  string(pointer.From(policy.State)),
+			"applies":                evaluation.Applies,
+			"not_applicable_reasons": evaluation.NotApplicableReasons,
+			"unevaluated_conditions": evaluation.UnevaluatedConditions,
+			"grant_controls":         flattenConditionalAccessGrantControls(policy.GrantControls),
+			"session_controls":       flattenConditionalAccessSessionControls(sessionControls),
+		})
+	}
+
+	grantControls := aggregateConditionalAccessGrantControls(applicablePolicies)
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(policyIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for policy IDs")
+	}
+
+	d.SetId("whatIf#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "applicable_policy_ids", applicablePolicyIds)
+	tf.Set(d, "authentication_strength_policy_ids", grantControls.AuthenticationStrengthPolicyIds)
+	tf.Set(d, "blocked", grantControls.Blocked)
+	tf.Set(d, "built_in_controls", grantControls.BuiltInControls)
+	tf.Set(d, "grant_control_alternatives", flattenConditionalAccessGrantControlAlternatives(grantControls.Alternatives))
+	tf.Set(d, "partially_evaluated_policy_ids", partiallyEvaluatedPolicyIds)
+	tf.Set(d, "policies", results)
+	tf.Set(d, "report_only_policy_ids", reportOnlyPolicyIds)
+	tf.Set(d, "session_controls", aggregateConditionalAccessSessionControls(applicablePolicies))
+	tf.Set(d, "terms_of_use", grantControls.TermsOfUse)
+
+	return nil
+}
+
+func flattenConditionalAccessGrantControlAlternatives(in []conditionalAccessGrantControlAlternative) []interface{} {
+	result := make([]interface{}, 0)
+	for _, v := range in {
+		result = append(result, map[string]interface{}{
+			"policy_id":                         v.PolicyId,
+			"built_in_controls":                 v.BuiltInControls,
+			"authentication_strength_policy_id": v.AuthenticationStrengthPolicyId,
+			"terms_of_use":                      v.TermsOfUse,
+		})
+	}
+	return result
+}
+
+func expandConditionalAccessSignIn(in []interface{}) conditionalAccessSignIn {
+	if len(in) == 0 || in[0] == nil {
+		return conditionalAccessSignIn{}
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return conditionalAccessSignIn{
+		UserId:                  config["user_object_id"].(string),
+		GroupIds:                tf.ExpandStringSlice(config["group_object_ids"].([]interface{})),
+		RoleTemplateIds:         tf.ExpandStringSlice(config["role_template_ids"].([]interface{})),
+		GuestOrExternalUserType: config["guest_or_external_user_type"].(string),
+		ExternalTenantId:        config["external_tenant_id"].(string),
+		ApplicationId:           config["application_id"].(string),
+		UserAction:              config["user_action"].(string),
+		AuthenticationContext:   config["authentication_context_class_reference"].(string),
+		AuthenticationFlow:      config["authentication_flow"].(string),
+		ClientAppType:           config["client_app_type"].(string),
+		DevicePlatform:          config["device_platform"].(string),
+		LocationId:              config["location_id"].(string),
+		TrustedLocation:         config["trusted_location"].(bool),
+		InsiderRiskLevel:        config["insider_risk_level"].(string),
+		SignInRiskLevel:         config["sign_in_risk_level"].(string),
+		UserRiskLevel:           config["user_risk_level"].(string),
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ConditionalAccessWhatIfDataSource struct{}
+
+func TestAccConditionalAccessWhatIfDataSource_applies(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_what_if", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ConditionalAccessWhatIfDataSource{}.signIn(data, "azuread_user.test.object_id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policies.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policies.0.applies").HasValue("true"),
+				check.That(data.ResourceName).Key("policies.0.grant_controls.0.built_in_controls.#").HasValue("1"),
+				check.That(data.ResourceName).Key("applicable_policy_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("report_only_policy_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blocked").HasValue("false"),
+			),
+		},
+	})
+}
+
+func TestAccConditionalAccessWhatIfDataSource_excluded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_what_if", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ConditionalAccessWhatIfDataSource{}.signIn(data, "azuread_user.excluded.object_id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policies.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policies.0.applies").HasValue("false"),
+				check.That(data.ResourceName).Key("policies.0.not_applicable_reasons.0").HasValue("user is excluded"),
+				check.That(data.ResourceName).Key("report_only_policy_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ConditionalAccessWhatIfDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-A"
+  password            = "%[2]s"
+}
+
+resource "azuread_user" "excluded" {
+  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-B"
+  password            = "%[2]s"
+}
+
+resource "azuread_conditional_access_policy" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "enabledForReportingButNotEnforced"
+
+  conditions {
+    client_app_types = ["all"]
+
+    applications {
+      included_applications = ["All"]
+    }
+
+    users {
+      included_users = ["All"]
+      excluded_users = [azuread_user.excluded.object_id]
+    }
+  }
+
+  grant_controls {
+    operator          = "OR"
+    built_in_controls = ["block"]
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r ConditionalAccessWhatIfDataSource) signIn(data acceptance.TestData, userObjectId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_conditional_access_what_if" "test" {
+  policy_ids = [azuread_conditional_access_policy.test.id]
+
+  sign_in {
+    user_object_id  = %[2]s
+    application_id  = "00000003-0000-0000-c000-000000000000"
+    client_app_type = "browser"
+  }
+}
+`, r.template(data), userObjectId)
+}
@@ -4,15 +4,18 @@
 package conditionalaccess
 
 import (
+	"net/netip"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/sdk/extendedpolicy"
 )
 
-func flattenConditionalAccessConditionSet(in *stable.ConditionalAccessConditionSet) []interface{} {
+func flattenConditionalAccessConditionSet(in *extendedpolicy.ConditionalAccessConditionSet) []interface{} {
 	if in == nil {
 		return []interface{}{}
 	}
@@ -37,13 +40,22 @@ func flattenConditionalAccessConditionSet(in *stable.ConditionalAccessConditionS
 		userRiskLevels = append(userRiskLevels, string(v))
 	}
 
+	insiderRiskLevels := make([]string, 0)
+	if in.InsiderRiskLevels != nil && *in.InsiderRiskLevels != "" {
+		for _, v := range strings.Split(string(*in.InsiderRiskLevels), ",") {
+			insiderRiskLevels = append(insiderRiskLevels, strings.TrimSpace(v))
+		}
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"applications":                  flattenConditionalAccessApplications(in.Applications),
+			"authentication_flows":          flattenConditionalAccessAuthenticationFlows(in.AuthenticationFlows),
 			"client_applications":           flattenConditionalAccessClientApplications(in.ClientApplications),
 			"users":                         flattenConditionalAccessUsers(in.Users),
 			"client_app_types":              clientAppTypes,
 			"devices":                       flattenConditionalAccessDevices(in.Devices),
+			"insider_risk_levels":           insiderRiskLevels,
 			"locations":                     flattenConditionalAccessLocations(in.Locations),
 			"platforms":                     flattenConditionalAccessPlatforms(in.Platforms),
 			"service_principal_risk_levels": servicePrincipalRiskLevels,
@@ -56,9 +68,35 @@ func flattenConditionalAccessConditionSet(in *stable.ConditionalAccessConditionS
 func flattenConditionalAccessApplications(in stable.ConditionalAccessApplications) []interface{} {
 	return []interface{}{
 		map[string]interface{}{
-			"included_applications": tf.FlattenStringSlicePtr(in.IncludeApplications),
-			"excluded_applications": tf.FlattenStringSlicePtr(in.ExcludeApplications),
-			"included_user_actions": tf.FlattenStringSlicePtr(in.IncludeUserActions),
+			"included_applications":                            tf.FlattenStringSlicePtr(in.IncludeApplications),
+			"excluded_applications":                            tf.FlattenStringSlicePtr(in.ExcludeApplications),
+			"included_authentication_context_class_references": tf.FlattenStringSlicePtr(in.IncludeAuthenticationContextClassReferences),
+			"included_user_actions":                            tf.FlattenStringSlicePtr(in.IncludeUserActions),
+			"application_filter":                               flattenConditionalAccessFilter(in.ApplicationFilter),
+		},
+	}
+}
+
+func flattenConditionalAccessAuthenticationFlows(in *extendedpolicy.ConditionalAccessAuthenticationFlows) []interface{} {
+	if in == nil || in.TransferMethods == nil {
+		return []interface{}{}
+	}
+
+	transferMethods := make([]string, 0)
+	for _, v := range strings.Split(string(*in.TransferMethods), ",") {
+		if v = strings.TrimSpace(v); v != "" && v != string(extendedpolicy.ConditionalAccessTransferMethods_None) {
+			transferMethods = append(transferMethods, v)
+		}
+	}
+
+	// The API returns a transfer method of `none` when authentication flows have been removed from a policy
+	if len(transferMethods) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"transfer_methods": transferMethods,
 		},
 	}
 }
@@ -72,6 +110,7 @@ func flattenConditionalAccessClientApplications(in *stable.ConditionalAccessClie
 		map[string]interface{}{
 			"included_service_principals": tf.FlattenStringSlicePtr(in.IncludeServicePrincipals),
 			"excluded_service_principals": tf.FlattenStringSlicePtr(in.ExcludeServicePrincipals),
+			"service_principal_filter":    flattenConditionalAccessFilter(in.ServicePrincipalFilter),
 		},
 	}
 }
@@ -102,7 +141,7 @@ func flattenConditionalAccessDevices(in *stable.ConditionalAccessDevices) []inte
 
 	return []interface{}{
 		map[string]interface{}{
-			"filter": flattenConditionalAccessDeviceFilter(in.DeviceFilter),
+			"filter": flattenConditionalAccessFilter(in.DeviceFilter),
 		},
 	}
 }
@@ -169,6 +208,32 @@ func flattenConditionalAccessGrantControls(in *stable.ConditionalAccessGrantCont
 	}
 }
 
+// flattenConditionalAccessPolicySessionControls flattens the session controls of a policy, including those session
+// controls which are only available in the beta API
+func flattenConditionalAccessPolicySessionControls(in *extendedpolicy.ConditionalAccessSessionControls) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	result := flattenConditionalAccessSessionControls(&in.ConditionalAccessSessionControls)
+
+	continuousAccessEvaluationMode := ""
+	if in.ContinuousAccessEvaluation != nil {
+		continuousAccessEvaluationMode = string(pointer.From(in.ContinuousAccessEvaluation.Mode))
+	}
+
+	secureSignInSessionEnabled := false
+	if in.SecureSignInSession != nil {
+		secureSignInSessionEnabled = in.SecureSignInSession.IsEnabled.GetOrZero()
+	}
+
+	sessionControls := result[0].(map[string]interface{})
+	sessionControls["continuous_access_evaluation_mode"] = continuousAccessEvaluationMode
+	sessionControls["secure_sign_in_session_enabled"] = secureSignInSessionEnabled
+
+	return result
+}
+
 func flattenConditionalAccessSessionControls(in *stable.ConditionalAccessSessionControls) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -222,7 +287,7 @@ func flattenConditionalAccessSessionControls(in *stable.ConditionalAccessSession
 	}
 }
 
-func flattenConditionalAccessDeviceFilter(in *stable.ConditionalAccessFilter) []interface{} {
+func flattenConditionalAccessFilter(in *stable.ConditionalAccessFilter) []interface{} {
 	if in == nil {
 		return []interface{}{}
 	}
@@ -278,9 +343,15 @@ func flattenCountryNamedLocation(in *stable.CountryNamedLocation) []interface{}
 		includeUnknown = *in.IncludeUnknownCountriesAndRegions
 	}
 
+	countryLookupMethod := string(stable.CountryLookupMethodType_ClientIPAddress)
+	if in.CountryLookupMethod != nil {
+		countryLookupMethod = string(*in.CountryLookupMethod)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"countries_and_regions":                 tf.FlattenStringSlice(in.CountriesAndRegions),
+			"country_lookup_method":                 countryLookupMethod,
 			"include_unknown_countries_and_regions": includeUnknown,
 		},
 	}
@@ -326,12 +397,12 @@ func flattenIPNamedLocationIPRange(in []stable.IPRange) []interface{} {
 	return tf.FlattenStringSlice(result)
 }
 
-func expandConditionalAccessConditionSet(in []interface{}) *stable.ConditionalAccessConditionSet {
+func expandConditionalAccessConditionSet(in []interface{}) *extendedpolicy.ConditionalAccessConditionSet {
 	if len(in) == 0 || in[0] == nil {
 		return nil
 	}
 
-	result := stable.ConditionalAccessConditionSet{}
+	result := extendedpolicy.ConditionalAccessConditionSet{}
 	config := in[0].(map[string]interface{})
 
 	applications := config["applications"].([]interface{})
@@ -361,10 +432,17 @@ func expandConditionalAccessConditionSet(in []interface{}) *stable.ConditionalAc
 		userRiskLevels = append(userRiskLevels, stable.RiskLevel(elem.(string)))
 	}
 
+	var insiderRiskLevels *stable.ConditionalAccessInsiderRiskLevels
+	if v := config["insider_risk_levels"].([]interface{}); len(v) > 0 {
+		insiderRiskLevels = pointer.To(stable.ConditionalAccessInsiderRiskLevels(strings.Join(tf.ExpandStringSlice(v), ",")))
+	}
+
 	result.Applications = expandConditionalAccessApplications(applications)
+	result.AuthenticationFlows = expandConditionalAccessAuthenticationFlows(config["authentication_flows"].([]interface{}))
 	result.ClientAppTypes = clientAppTypes
 	result.ClientApplications = expandConditionalAccessClientApplications(clientApplications)
 	result.Devices = expandConditionalAccessDevices(devices)
+	result.InsiderRiskLevels = insiderRiskLevels
 	result.Locations = expandConditionalAccessLocations(locations)
 	result.Platforms = expandConditionalAccessPlatforms(platforms)
 	result.ServicePrincipalRiskLevels = &servicePrincipalRiskLevels
@@ -389,21 +467,44 @@ func expandConditionalAccessClientApplications(in []interface{}) *stable.Conditi
 	result.IncludeServicePrincipals = tf.ExpandStringSlicePtr(includeServicePrincipals)
 	result.ExcludeServicePrincipals = tf.ExpandStringSlicePtr(excludeServicePrincipals)
 
+	if servicePrincipalFilter := config["service_principal_filter"].([]interface{}); len(servicePrincipalFilter) > 0 {
+		result.ServicePrincipalFilter = expandConditionalAccessFilter(servicePrincipalFilter)
+	}
+
 	return &result
 }
 
+func expandConditionalAccessAuthenticationFlows(in []interface{}) *extendedpolicy.ConditionalAccessAuthenticationFlows {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	config := in[0].(map[string]interface{})
+	transferMethods := strings.Join(tf.ExpandStringSlice(config["transfer_methods"].([]interface{})), ",")
+
+	return &extendedpolicy.ConditionalAccessAuthenticationFlows{
+		TransferMethods: pointer.To(extendedpolicy.ConditionalAccessTransferMethods(transferMethods)),
+	}
+}
+
 func expandConditionalAccessApplications(in []interface{}) stable.ConditionalAccessApplications {
 	result := stable.ConditionalAccessApplications{}
 	config := in[0].(map[string]interface{})
 
 	includeApplications := config["included_applications"].([]interface{})
 	excludeApplications := config["excluded_applications"].([]interface{})
+	includeAuthenticationContextClassReferences := config["included_authentication_context_class_references"].([]interface{})
 	includeUserActions := config["included_user_actions"].([]interface{})
 
 	result.IncludeApplications = tf.ExpandStringSlicePtr(includeApplications)
 	result.ExcludeApplications = tf.ExpandStringSlicePtr(excludeApplications)
+	result.IncludeAuthenticationContextClassReferences = tf.ExpandStringSlicePtr(includeAuthenticationContextClassReferences)
 	result.IncludeUserActions = tf.ExpandStringSlicePtr(includeUserActions)
 
+	if applicationFilter := config["application_filter"].([]interface{}); len(applicationFilter) > 0 {
+		result.ApplicationFilter = expandConditionalAccessFilter(applicationFilter)
+	}
+
 	return result
 }
 
@@ -523,8 +624,8 @@ func expandConditionalAccessGrantControls(in []interface{}) (*stable.Conditional
 	return &result, nil
 }
 
-func expandConditionalAccessSessionControls(in []interface{}) *stable.ConditionalAccessSessionControls {
-	result := stable.ConditionalAccessSessionControls{}
+func expandConditionalAccessSessionControls(in []interface{}) *extendedpolicy.ConditionalAccessSessionControls {
+	result := extendedpolicy.ConditionalAccessSessionControls{}
 
 	if len(in) == 0 || in[0] == nil {
 		return &result
@@ -579,10 +680,22 @@ func expandConditionalAccessSessionControls(in []interface{}) *stable.Conditiona
 		result.SignInFrequency = &signInFrequency
 	}
 
+	if mode := config["continuous_access_evaluation_mode"].(string); mode != "" {
+		result.ContinuousAccessEvaluation = &beta.ContinuousAccessEvaluationSessionControl{
+			Mode: pointer.To(beta.ContinuousAccessEvaluationMode(mode)),
+		}
+	}
+
+	if config["secure_sign_in_session_enabled"].(bool) {
+		result.SecureSignInSession = &beta.SecureSignInSessionControl{
+			IsEnabled: nullable.Value(true),
+		}
+	}
+
 	// API does not accept ineffectual and sessionControls object, and it will not remove any existing sessionControls unless the entire object is set to null
 	if (result.ApplicationEnforcedRestrictions == nil || !result.ApplicationEnforcedRestrictions.IsEnabled.GetOrZero()) &&
 		result.CloudAppSecurity == nil && !result.DisableResilienceDefaults.GetOrZero() &&
-		result.PersistentBrowser == nil && result.SignInFrequency == nil {
+		result.PersistentBrowser == nil && result.SignInFrequency == nil && !result.UsesBetaControls() {
 		return nil
 	}
 
@@ -659,6 +772,10 @@ func expandCountryNamedLocation(in []interface{}) *stable.CountryNamedLocation {
 	result.CountriesAndRegions = tf.ExpandStringSlice(countriesAndRegions)
 	result.IncludeUnknownCountriesAndRegions = pointer.To(includeUnknown.(bool))
 
+	if countryLookupMethod, ok := config["country_lookup_method"].(string); ok && countryLookupMethod != "" {
+		result.CountryLookupMethod = pointer.To(stable.CountryLookupMethodType(countryLookupMethod))
+	}
+
 	return &result
 }
 
@@ -682,8 +799,17 @@ func expandIPNamedLocation(in []interface{}) *stable.IPNamedLocation {
 func expandIPNamedLocationIPRange(in []interface{}) []stable.IPRange {
 	result := make([]stable.IPRange, 0)
 	for _, cidr := range in {
+		cidrAddress := normalizeIPRange(cidr.(string))
+
+		if prefix, err := netip.ParsePrefix(cidrAddress); err == nil && prefix.Addr().Is6() {
+			result = append(result, stable.IPv6CIDRRange{
+				CIDRAddress: pointer.To(cidrAddress),
+			})
+			continue
+		}
+
 		result = append(result, stable.IPv4CIDRRange{
-			CIDRAddress: pointer.To(cidr.(string)),
+			CIDRAddress: pointer.To(cidrAddress),
 		})
 	}
 
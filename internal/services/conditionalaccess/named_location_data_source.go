@@ -69,6 +69,11 @@ func namedLocationDataSource() *pluginsdk.Resource {
 							},
 						},
 
+						"country_lookup_method": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"include_unknown_countries_and_regions": {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
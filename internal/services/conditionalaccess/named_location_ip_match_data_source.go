@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessnamedlocation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func namedLocationIPMatchDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: namedLocationIPMatchDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"ip_address": {
+				Description:  "The IPv4 or IPv6 address to test against the IP named locations in the tenant",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"trusted": {
+				Description: "Whether the IP address falls within at least one trusted IP named location",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"named_locations": {
+				Description: "The IP named locations which contain the IP address",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the named location",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the named location",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"trusted": {
+							Description: "Whether the named location is trusted",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"matched_ip_ranges": {
+							Description: "The IP ranges in the named location which contain the IP address",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func namedLocationIPMatchDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.NamedLocationClient
+
+	ipAddress, err := netip.ParseAddr(d.Get("ip_address").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "ip_address", "Parsing IP address")
+	}
+
+	resp, err := client.ListConditionalAccessNamedLocations(ctx, conditionalaccessnamedlocation.DefaultListConditionalAccessNamedLocationsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing named locations")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Bad API Response")
+	}
+
+	// Country named locations cannot be evaluated here, since the country of an IP address is determined by the service
+	trusted := false
+	namedLocationIds := make([]string, 0)
+	namedLocations := make([]map[string]interface{}, 0)
+	for _, item := range *resp.Model {
+		namedLocation, ok := item.(stable.IPNamedLocation)
+		if !ok {
+			continue
+		}
+
+		matchedIPRanges := make([]string, 0)
+		for _, ipRange := range flattenNamedLocationIPRanges(namedLocation) {
+			if ipRange.Prefix.Contains(ipAddress.Unmap()) {
+				matchedIPRanges = append(matchedIPRanges, ipRange.Prefix.String())
+			}
+		}
+
+		if len(matchedIPRanges) == 0 {
+			continue
+		}
+
+		id := stable.NewIdentityConditionalAccessNamedLocationID(pointer.From(namedLocation.Id))
+		namedLocationIds = append(namedLocationIds, id.NamedLocationId)
+
+		if pointer.From(namedLocation.IsTrusted) {
+			trusted = true
+		}
+
+		namedLocations = append(namedLocations, map[string]interface{}{
+			"id":                id.ID(),
+			"display_name":      pointer.From(namedLocation.DisplayName),
+			"trusted":           pointer.From(namedLocation.IsTrusted),
+			"matched_ip_ranges": matchedIPRanges,
+		})
+	}
+
+	sort.Slice(namedLocations, func(i, j int) bool {
+		return namedLocations[i]["id"].(string) < namedLocations[j]["id"].(string)
+	})
+	sort.Strings(namedLocationIds)
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(ipAddress.String() + "/" + strings.Join(namedLocationIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for named location IDs")
+	}
+
+	d.SetId("namedLocationIPMatch#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "named_locations", namedLocations)
+	tf.Set(d, "trusted", trusted)
+
+	return nil
+}
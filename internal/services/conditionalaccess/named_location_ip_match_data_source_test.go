@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type NamedLocationIPMatchDataSource struct{}
+
+func TestAccNamedLocationIPMatchDataSource_match(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_named_location_ip_match", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: NamedLocationIPMatchDataSource{}.ipAddress(data, "3.3.3.3"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("trusted").HasValue("true"),
+				check.That(data.ResourceName).Key("named_locations.#").HasValue("1"),
+				check.That(data.ResourceName).Key("named_locations.0.id").Exists(),
+				check.That(data.ResourceName).Key("named_locations.0.trusted").HasValue("true"),
+				check.That(data.ResourceName).Key("named_locations.0.matched_ip_ranges.#").HasValue("1"),
+				check.That(data.ResourceName).Key("named_locations.0.matched_ip_ranges.0").HasValue("3.3.3.3/32"),
+			),
+		},
+	})
+}
+
+func TestAccNamedLocationIPMatchDataSource_noMatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_named_location_ip_match", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: NamedLocationIPMatchDataSource{}.ipAddress(data, "192.0.2.1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("trusted").HasValue("false"),
+				check.That(data.ResourceName).Key("named_locations.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (NamedLocationIPMatchDataSource) ipAddress(data acceptance.TestData, ipAddress string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_named_location_ip_match" "test" {
+  ip_address = "%[2]s"
+
+  depends_on = [azuread_named_location.test]
+}
+`, NamedLocationResource{}.completeIP(data), ipAddress)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// namedLocationIPRange is an IP range belonging to a named location, used when checking for overlaps between ranges
+type namedLocationIPRange struct {
+	NamedLocationId   string
+	NamedLocationName string
+	Trusted           bool
+	Prefix            netip.Prefix
+}
+
+// normalizeIPRange returns the canonical form of a CIDR range, with host bits cleared and IPv6 addresses zero-compressed,
+// so that equivalent ranges such as `10.0.0.1/8` and `10.0.0.0/8` compare as equal. Values which cannot be parsed are
+// returned unchanged.
+func normalizeIPRange(in string) string {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(in))
+	if err != nil {
+		return in
+	}
+
+	return prefix.Masked().String()
+}
+
+// normalizeIPRanges returns the canonical form of each CIDR range in the provided slice
+func normalizeIPRanges(in []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(in))
+	for _, v := range in {
+		cidr, _ := v.(string)
+		result = append(result, normalizeIPRange(cidr))
+	}
+
+	return result
+}
+
+// namedLocationIPRangeDiffSuppress suppresses differences between equivalent CIDR ranges
+func namedLocationIPRangeDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return old != "" && new != "" && normalizeIPRange(old) == normalizeIPRange(new)
+}
+
+// flattenNamedLocationIPRanges returns the parsed and masked prefixes for an IP named location
+func flattenNamedLocationIPRanges(in stable.IPNamedLocation) []namedLocationIPRange {
+	result := make([]namedLocationIPRange, 0)
+	for _, v := range flattenIPNamedLocationIPRange(in.IPRanges) {
+		prefix, err := netip.ParsePrefix(v.(string))
+		if err != nil {
+			continue
+		}
+
+		result = append(result, namedLocationIPRange{
+			NamedLocationId:   pointer.From(in.Id),
+			NamedLocationName: pointer.From(in.DisplayName),
+			Trusted:           pointer.From(in.IsTrusted),
+			Prefix:            prefix.Masked(),
+		})
+	}
+
+	return result
+}
+
+// namedLocationIPRangeOverlapWarnings returns a warning diagnostic for each range in `ranges` which overlaps another range
+// in the same set, or a range belonging to one of the `others` named locations
+func namedLocationIPRangeOverlapWarnings(ranges []interface{}, others []namedLocationIPRange) pluginsdk.Diagnostics {
+	var diags pluginsdk.Diagnostics
+
+	prefixes := make([]netip.Prefix, 0, len(ranges))
+	for _, v := range ranges {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(v.(string)))
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	for i, a := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if b := prefixes[j]; a.Overlaps(b) {
+				diags = append(diags, pluginsdk.Diagnostic{
+					Severity: pluginsdk.DiagWarning,
+					Summary:  "Overlapping IP ranges in named location",
+					Detail:   fmt.Sprintf("The IP range %q overlaps with the IP range %q in the same named location", a.String(), b.String()),
+				})
+			}
+		}
+
+		for _, other := range others {
+			if a.Overlaps(other.Prefix) {
+				trusted := ""
+				if other.Trusted {
+					trusted = "trusted "
+				}
+
+				diags = append(diags, pluginsdk.Diagnostic{
+					Severity: pluginsdk.DiagWarning,
+					Summary:  "IP range overlaps with another named location",
+					Detail: fmt.Sprintf("The IP range %q overlaps with the IP range %q in the %snamed location %q (ID: %s)",
+						a.String(), other.Prefix.String(), trusted, other.NamedLocationName, other.NamedLocationId),
+				})
+			}
+		}
+	}
+
+	return diags
+}
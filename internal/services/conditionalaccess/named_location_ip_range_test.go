@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestNormalizeIPRange(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "10.0.0.0/8", Expected: "10.0.0.0/8"},
+		{Input: "10.0.0.1/8", Expected: "10.0.0.0/8"},
+		{Input: " 192.168.1.77/24", Expected: "192.168.1.0/24"},
+		{Input: "2001:0db8:0000:0000:0000:0000:0000:0000/32", Expected: "2001:db8::/32"},
+		{Input: "2001:DB8:0:0:1::1/64", Expected: "2001:db8::/64"},
+		{Input: "not-a-cidr", Expected: "not-a-cidr"},
+	}
+
+	for _, tc := range cases {
+		if actual := normalizeIPRange(tc.Input); actual != tc.Expected {
+			t.Errorf("normalizeIPRange(%q): expected %q, got %q", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
+func TestNamedLocationIPRangeDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{Old: "10.0.0.0/8", New: "10.0.0.1/8", Suppress: true},
+		{Old: "2001:db8::/32", New: "2001:0db8:0:0::/32", Suppress: true},
+		{Old: "10.0.0.0/8", New: "10.0.0.0/16", Suppress: false},
+		{Old: "", New: "10.0.0.0/8", Suppress: false},
+	}
+
+	for _, tc := range cases {
+		if actual := namedLocationIPRangeDiffSuppress("", tc.Old, tc.New, nil); actual != tc.Suppress {
+			t.Errorf("namedLocationIPRangeDiffSuppress(%q, %q): expected %t, got %t", tc.Old, tc.New, tc.Suppress, actual)
+		}
+	}
+}
+
+func TestNamedLocationIPRangeOverlapWarnings(t *testing.T) {
+	others := []namedLocationIPRange{
+		{
+			NamedLocationId:   "00000000-0000-0000-0000-000000000001",
+			NamedLocationName: "office",
+			Trusted:           true,
+			Prefix:            netip.MustParsePrefix("192.168.0.0/16"),
+		},
+		{
+			NamedLocationId:   "00000000-0000-0000-0000-000000000002",
+			NamedLocationName: "datacenter",
+			Prefix:            netip.MustParsePrefix("2001:db8::/32"),
+		},
+	}
+
+	cases := []struct {
+		TestName string
+		Ranges   []interface{}
+		Warnings int
+	}{
+		{
+			TestName: "no overlaps",
+			Ranges:   []interface{}{"10.0.0.0/8", "172.16.0.0/12"},
+			Warnings: 0,
+		},
+		{
+			TestName: "overlap within the same location",
+			Ranges:   []interface{}{"10.0.0.0/8", "10.1.0.0/16"},
+			Warnings: 1,
+		},
+		{
+			TestName: "equivalent ranges within the same location",
+			Ranges:   []interface{}{"10.0.0.0/8", "10.0.0.1/8"},
+			Warnings: 1,
+		},
+		{
+			TestName: "overlap with another location",
+			Ranges:   []interface{}{"192.168.10.0/24"},
+			Warnings: 1,
+		},
+		{
+			TestName: "IPv6 overlap with another location",
+			Ranges:   []interface{}{"2001:db8:1::/48", "64:ff9b::/96"},
+			Warnings: 1,
+		},
+		{
+			TestName: "overlaps within and across locations",
+			Ranges:   []interface{}{"192.168.0.0/24", "192.168.0.128/25"},
+			Warnings: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := namedLocationIPRangeOverlapWarnings(tc.Ranges, others); len(actual) != tc.Warnings {
+				t.Fatalf("expected %d warnings, got %d: %+v", tc.Warnings, len(actual), actual)
+			}
+		})
+	}
+}
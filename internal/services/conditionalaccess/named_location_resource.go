@@ -76,8 +76,9 @@ func namedLocationResource() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeList,
 							Required: true,
 							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.PrefixLengthAtLeast(8),
+								Type:             pluginsdk.TypeString,
+								ValidateFunc:     validation.PrefixLengthAtLeast(8),
+								DiffSuppressFunc: namedLocationIPRangeDiffSuppress,
 							},
 						},
 
@@ -106,6 +107,13 @@ func namedLocationResource() *pluginsdk.Resource {
 							},
 						},
 
+						"country_lookup_method": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.CountryLookupMethodType_ClientIPAddress),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForCountryLookupMethodType(), false),
+						},
+
 						"include_unknown_countries_and_regions": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -119,6 +127,7 @@ func namedLocationResource() *pluginsdk.Resource {
 
 func namedLocationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).ConditionalAccess.NamedLocationClient
+	var diags pluginsdk.Diagnostics
 
 	if v, ok := d.GetOk("ip"); ok {
 		properties := expandIPNamedLocation(v.([]interface{}))
@@ -145,6 +154,8 @@ func namedLocationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData,
 		id := stable.NewIdentityConditionalAccessNamedLocationID(*namedLocation.Id)
 		d.SetId(id.ID())
 
+		diags = namedLocationIPRangeOverlapDiags(ctx, client, *namedLocation.Id, properties.IPRanges)
+
 	} else if v, ok = d.GetOk("country"); ok {
 		properties := expandCountryNamedLocation(v.([]interface{}))
 		properties.DisplayName = pointer.To(d.Get("display_name").(string))
@@ -174,11 +185,12 @@ func namedLocationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData,
 		return tf.ErrorDiagF(errors.New("one of `ip` or `country` must be specified"), "Unable to determine named location type")
 	}
 
-	return namedLocationResourceRead(ctx, d, meta)
+	return append(diags, namedLocationResourceRead(ctx, d, meta)...)
 }
 
 func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).ConditionalAccess.NamedLocationClient
+	var diags pluginsdk.Diagnostics
 
 	id, err := stable.ParseIdentityConditionalAccessNamedLocationID(d.Id())
 	if err != nil {
@@ -215,7 +227,7 @@ func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData,
 				location := locationRaw[0].(map[string]interface{})
 				ip := v.([]interface{})[0].(map[string]interface{})
 
-				if !reflect.DeepEqual(location["ip_ranges"], ip["ip_ranges"]) {
+				if !reflect.DeepEqual(normalizeIPRanges(location["ip_ranges"].([]interface{})), normalizeIPRanges(ip["ip_ranges"].([]interface{}))) {
 					return pointer.To(false), nil
 				}
 
@@ -229,6 +241,8 @@ func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData,
 			return tf.ErrorDiagF(err, "waiting for update of %s", id)
 		}
 
+		diags = namedLocationIPRangeOverlapDiags(ctx, client, id.NamedLocationId, properties.IPRanges)
+
 	} else if v, ok := d.GetOk("country"); ok {
 		properties := expandCountryNamedLocation(v.([]interface{}))
 
@@ -266,6 +280,10 @@ func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData,
 				if location["include_unknown_countries_and_regions"].(bool) != ip["include_unknown_countries_and_regions"].(bool) {
 					return pointer.To(false), nil
 				}
+
+				if location["country_lookup_method"].(string) != ip["country_lookup_method"].(string) {
+					return pointer.To(false), nil
+				}
 			}
 
 			return pointer.To(true), nil
@@ -274,7 +292,7 @@ func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData,
 		}
 	}
 
-	return namedLocationResourceRead(ctx, d, meta)
+	return append(diags, namedLocationResourceRead(ctx, d, meta)...)
 }
 
 func namedLocationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
@@ -368,3 +386,25 @@ func namedLocationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData,
 
 	return nil
 }
+
+// namedLocationIPRangeOverlapDiags returns warning diagnostics for any of the provided IP ranges which overlap with each
+// other, or with the IP ranges of any other IP named location in the tenant. Overlapping ranges are permitted by the API
+// but make it difficult to reason about which location, and whether a trusted location, applies to a sign-in.
+// Only named locations which already exist in the tenant are compared, so sibling resources in the same configuration
+// which have not yet been created are not considered.
+func namedLocationIPRangeOverlapDiags(ctx context.Context, client *conditionalaccessnamedlocation.ConditionalAccessNamedLocationClient, namedLocationId string, ipRanges []stable.IPRange) pluginsdk.Diagnostics {
+	others := make([]namedLocationIPRange, 0)
+
+	resp, err := client.ListConditionalAccessNamedLocations(ctx, conditionalaccessnamedlocation.DefaultListConditionalAccessNamedLocationsOperationOptions())
+	if err != nil {
+		log.Printf("[DEBUG] Could not list named locations to check for overlapping IP ranges: %v", err)
+	} else if resp.Model != nil {
+		for _, item := range *resp.Model {
+			if namedLocation, ok := item.(stable.IPNamedLocation); ok && pointer.From(namedLocation.Id) != namedLocationId {
+				others = append(others, flattenNamedLocationIPRanges(namedLocation)...)
+			}
+		}
+	}
+
+	return namedLocationIPRangeOverlapWarnings(flattenIPNamedLocationIPRange(ipRanges), others)
+}
@@ -79,6 +79,41 @@ func TestAccNamedLocation_updateIP(t *testing.T) {
 	})
 }
 
+func TestAccNamedLocation_nonCanonicalIP(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nonCanonicalIP(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip.0.ip_ranges.0").HasValue("10.0.0.0/8"),
+				check.That(data.ResourceName).Key("ip.0.ip_ranges.1").HasValue("2001:db8::/32"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNamedLocation_overlappingIP(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+
+	// Overlap checks are made at apply time against named locations which already exist in the tenant, so sibling
+	// named locations which are created in the same apply are not compared, and neither is expected to fail
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.overlappingIP(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_named_location.other").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNamedLocation_basicCountry(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
 	r := NamedLocationResource{}
@@ -109,6 +144,30 @@ func TestAccNamedLocation_completeCountry(t *testing.T) {
 	})
 }
 
+func TestAccNamedLocation_countryLookupMethod(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicCountry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("country.0.country_lookup_method").HasValue("clientIpAddress"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.countryLookupMethod(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("country.0.country_lookup_method").HasValue("authenticatorAppGps"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNamedLocation_updateCountry(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
 	r := NamedLocationResource{}
@@ -188,6 +247,42 @@ resource "azuread_named_location" "test" {
 `, data.RandomInteger)
 }
 
+func (NamedLocationResource) nonCanonicalIP(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNLIP-%[1]d"
+  ip {
+    ip_ranges = [
+      "10.0.0.1/8",
+      "2001:0db8:0000:0000::/32",
+    ]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (NamedLocationResource) overlappingIP(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNLIP-%[1]d"
+  ip {
+    ip_ranges = [
+      "10.0.0.0/8",
+    ]
+  }
+}
+
+resource "azuread_named_location" "other" {
+  display_name = "acctestNLIP-other-%[1]d"
+  ip {
+    ip_ranges = [
+      "10.1.0.0/16",
+    ]
+  }
+}
+`, data.RandomInteger)
+}
+
 func (NamedLocationResource) basicCountry(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_named_location" "test" {
@@ -217,3 +312,18 @@ resource "azuread_named_location" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (NamedLocationResource) countryLookupMethod(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNLC-%[1]d"
+  country {
+    countries_and_regions = [
+      "GB",
+      "US",
+    ]
+    country_lookup_method = "authenticatorAppGps"
+  }
+}
+`, data.RandomInteger)
+}
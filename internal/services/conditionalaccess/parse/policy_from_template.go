@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type PolicyFromTemplateId struct {
+	TemplateId string
+	PolicyId   string
+}
+
+func NewPolicyFromTemplateID(templateId, policyId string) *PolicyFromTemplateId {
+	return &PolicyFromTemplateId{
+		TemplateId: templateId,
+		PolicyId:   policyId,
+	}
+}
+
+// ParsePolicyFromTemplateID parses 'input' into a PolicyFromTemplateId
+func ParsePolicyFromTemplateID(input string) (*PolicyFromTemplateId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PolicyFromTemplateId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &PolicyFromTemplateId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidatePolicyFromTemplateID checks that 'input' can be parsed as a Policy From Template ID
+func ValidatePolicyFromTemplateID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParsePolicyFromTemplateID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.TemplateId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.PolicyId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	return
+}
+
+func (id *PolicyFromTemplateId) ID() string {
+	fmtString := "/identity/conditionalAccess/templates/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.TemplateId, id.PolicyId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *PolicyFromTemplateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("identity", "identity", "identity"),
+		resourceids.StaticSegment("conditionalAccess", "conditionalAccess", "conditionalAccess"),
+		resourceids.StaticSegment("templates", "templates", "templates"),
+		resourceids.UserSpecifiedSegment("templateId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.UserSpecifiedSegment("policyId", "11111111-1111-1111-1111-111111111111"),
+	}
+}
+
+func (id *PolicyFromTemplateId) String() string {
+	return fmt.Sprintf("Conditional Access Policy From Template (Template ID: %q, Policy ID: %q)", id.TemplateId, id.PolicyId)
+}
+
+func (id *PolicyFromTemplateId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.TemplateId, ok = input.Parsed["templateId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "templateId", input)
+	}
+
+	if id.PolicyId, ok = input.Parsed["policyId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyId", input)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package conditionalaccess
 
 import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
 )
 
 type Registration struct{}
@@ -29,14 +30,30 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_named_location": namedLocationDataSource(),
+		"azuread_conditional_access_templates": conditionalAccessTemplatesDataSource(),
+		"azuread_conditional_access_what_if":   conditionalAccessWhatIfDataSource(),
+		"azuread_named_location":               namedLocationDataSource(),
+		"azuread_named_location_ip_match":      namedLocationIPMatchDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_named_location":            namedLocationResource(),
-		"azuread_conditional_access_policy": conditionalAccessPolicyResource(),
+		"azuread_conditional_access_authentication_context": conditionalAccessAuthenticationContextResource(),
+		"azuread_conditional_access_policy":                 conditionalAccessPolicyResource(),
+		"azuread_named_location":                            namedLocationResource(),
+	}
+}
+
+// DataSources returns the typed DataSources supported by this service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns the typed Resources supported by this service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ConditionalAccessPolicyFromTemplateResource{},
 	}
 }
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// PolicyClient reads and writes conditional access policies using the extended ConditionalAccessPolicy model. Other
+// operations, such as deleting policies, should use the identity/stable/conditionalaccesspolicy package in go-azure-sdk.
+type PolicyClient struct {
+	Client *msgraph.Client
+}
+
+func NewPolicyClientWithBaseURI(sdkApi sdkEnv.Api) (*PolicyClient, error) {
+	c, err := msgraph.NewClient(sdkApi, "conditionalaccesspolicy", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PolicyClient: %+v", err)
+	}
+
+	return &PolicyClient{
+		Client: c,
+	}, nil
+}
+
+// NewBetaPolicyClientWithBaseURI returns a PolicyClient using the beta API. Writing a policy with the beta API prevents
+// it from subsequently being read or written with the v1.0 API, so this should only be used for policies which specify
+// session controls that are only available in the beta API.
+func NewBetaPolicyClientWithBaseURI(sdkApi sdkEnv.Api) (*PolicyClient, error) {
+	c, err := msgraph.NewClient(sdkApi, "conditionalaccesspolicy", betaApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PolicyClient: %+v", err)
+	}
+
+	return &PolicyClient{
+		Client: c,
+	}, nil
+}
+
+type PolicyOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ConditionalAccessPolicy
+}
+
+type ListPoliciesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]ConditionalAccessPolicy
+}
+
+type listPoliciesPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *listPoliciesPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// CreateConditionalAccessPolicy creates a conditional access policy
+func (c PolicyClient) CreateConditionalAccessPolicy(ctx context.Context, input ConditionalAccessPolicy) (result PolicyOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, "/identity/conditionalAccess/policies", input)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ConditionalAccessPolicy
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// GetConditionalAccessPolicy retrieves a conditional access policy
+func (c PolicyClient) GetConditionalAccessPolicy(ctx context.Context, id stable.IdentityConditionalAccessPolicyId) (result PolicyOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ConditionalAccessPolicy
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// UpdateConditionalAccessPolicy updates the properties of a conditional access policy
+func (c PolicyClient) UpdateConditionalAccessPolicy(ctx context.Context, id stable.IdentityConditionalAccessPolicyId, input ConditionalAccessPolicy) (result PolicyOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// ListConditionalAccessPolicies retrieves all conditional access policies in the tenant
+func (c PolicyClient) ListConditionalAccessPolicies(ctx context.Context) (result ListPoliciesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Pager:      &listPoliciesPager{},
+		Path:       "/identity/conditionalAccess/policies",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]ConditionalAccessPolicy `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+func (c PolicyClient) execute(ctx context.Context, method, path string, input interface{}) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: method,
+		Path:       path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return req.Execute(ctx)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedpolicy
+
+type ConditionalAccessTransferMethods string
+
+const (
+	ConditionalAccessTransferMethods_AuthenticationTransfer ConditionalAccessTransferMethods = "authenticationTransfer"
+	ConditionalAccessTransferMethods_DeviceCodeFlow         ConditionalAccessTransferMethods = "deviceCodeFlow"
+	ConditionalAccessTransferMethods_None                   ConditionalAccessTransferMethods = "none"
+)
+
+func PossibleValuesForConditionalAccessTransferMethods() []string {
+	return []string{
+		string(ConditionalAccessTransferMethods_AuthenticationTransfer),
+		string(ConditionalAccessTransferMethods_DeviceCodeFlow),
+		string(ConditionalAccessTransferMethods_None),
+	}
+}
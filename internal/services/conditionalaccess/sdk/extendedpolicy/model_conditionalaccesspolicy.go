@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+// ConditionalAccessPolicy extends stable.ConditionalAccessPolicy with an extended condition set and session controls
+type ConditionalAccessPolicy struct {
+	stable.ConditionalAccessPolicy
+
+	// Rules that must be met for the policy to apply. This shadows the Conditions field of the embedded policy.
+	Conditions *ConditionalAccessConditionSet `json:"-"`
+
+	// Session controls that are enforced after sign-in. This shadows the SessionControls field of the embedded policy.
+	SessionControls *ConditionalAccessSessionControls `json:"-"`
+}
+
+func (s ConditionalAccessPolicy) MarshalJSON() ([]byte, error) {
+	policy := s.ConditionalAccessPolicy
+	policy.Conditions = nil
+	policy.SessionControls = nil
+
+	encoded, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ConditionalAccessPolicy: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ConditionalAccessPolicy: %+v", err)
+	}
+
+	if s.Conditions != nil {
+		decoded["conditions"] = s.Conditions
+	}
+	if s.SessionControls != nil {
+		decoded["sessionControls"] = s.SessionControls
+	}
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ConditionalAccessPolicy: %+v", err)
+	}
+
+	return encoded, nil
+}
+
+func (s *ConditionalAccessPolicy) UnmarshalJSON(bytes []byte) error {
+	if err := json.Unmarshal(bytes, &s.ConditionalAccessPolicy); err != nil {
+		return fmt.Errorf("unmarshaling ConditionalAccessPolicy: %+v", err)
+	}
+
+	var decoded struct {
+		Conditions      *ConditionalAccessConditionSet    `json:"conditions,omitempty"`
+		SessionControls *ConditionalAccessSessionControls `json:"sessionControls,omitempty"`
+	}
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling ConditionalAccessPolicy conditions: %+v", err)
+	}
+
+	s.Conditions = decoded.Conditions
+	s.ConditionalAccessPolicy.Conditions = nil
+	s.SessionControls = decoded.SessionControls
+	s.ConditionalAccessPolicy.SessionControls = nil
+
+	return nil
+}
+
+// ConditionalAccessConditionSet extends stable.ConditionalAccessConditionSet with conditions which are not yet modelled
+// by go-azure-sdk
+type ConditionalAccessConditionSet struct {
+	stable.ConditionalAccessConditionSet
+
+	// Authentication flows included in the policy scope
+	AuthenticationFlows *ConditionalAccessAuthenticationFlows `json:"authenticationFlows"`
+
+	// Insider risk levels included in the policy. This shadows the field of the embedded condition set, so that it is
+	// sent as null when unset, in order that it can be removed from a policy.
+	InsiderRiskLevels *stable.ConditionalAccessInsiderRiskLevels `json:"insiderRiskLevels"`
+}
+
+type ConditionalAccessAuthenticationFlows struct {
+	// The OData Type of this entity
+	ODataType *string `json:"@odata.type,omitempty"`
+
+	// Comma-separated list of transfer methods included in the policy scope
+	TransferMethods *ConditionalAccessTransferMethods `json:"transferMethods,omitempty"`
+}
+
+// ConditionalAccessSessionControls extends stable.ConditionalAccessSessionControls with session controls which are only
+// available in the beta API. Policies using these controls must be written and read using the beta API.
+type ConditionalAccessSessionControls struct {
+	stable.ConditionalAccessSessionControls
+
+	// Session control for continuous access evaluation settings
+	ContinuousAccessEvaluation *beta.ContinuousAccessEvaluationSessionControl `json:"continuousAccessEvaluation,omitempty"`
+
+	// Session control to require tokens to be bound to the device (token protection)
+	SecureSignInSession *beta.SecureSignInSessionControl `json:"secureSignInSession,omitempty"`
+}
+
+// UsesBetaControls returns whether any session controls which are only available in the beta API are specified
+func (s ConditionalAccessSessionControls) UsesBetaControls() bool {
+	return s.ContinuousAccessEvaluation != nil || s.SecureSignInSession != nil
+}
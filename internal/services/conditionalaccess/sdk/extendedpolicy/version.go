@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedpolicy
+
+const (
+	defaultApiVersion = "v1.0"
+	betaApiVersion    = "beta"
+)
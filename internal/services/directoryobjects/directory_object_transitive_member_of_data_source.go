@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func directoryObjectTransitiveMemberOfDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: directoryObjectTransitiveMemberOfDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"object_id": {
+				Description:  "The object ID of the principal for which to retrieve transitive memberships",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"security_enabled_only": {
+				Description: "Whether to only return security-enabled groups",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"check_group_object_ids": {
+				Description: "A set of group object IDs to check for transitive membership",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"matched_group_object_ids": {
+				Description: "The object IDs of groups from `check_group_object_ids` of which the principal is a transitive member",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"object_ids": {
+				Description: "The object IDs of all groups, administrative units and directory roles of which the principal is a transitive member",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"administrative_unit_object_ids": {
+				Description: "The object IDs of administrative units of which the principal is a transitive member",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"directory_role_object_ids": {
+				Description: "The object IDs of directory roles of which the principal is a transitive member",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"group_object_ids": {
+				Description: "The object IDs of groups of which the principal is a transitive member",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func directoryObjectTransitiveMemberOfDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.DirectoryObjectClient
+
+	id := stable.NewDirectoryObjectID(d.Get("object_id").(string))
+
+	request := directoryobject.GetMemberObjectsRequest{
+		SecurityEnabledOnly: nullable.Value(d.Get("security_enabled_only").(bool)),
+	}
+
+	resp, err := client.GetMemberObjects(ctx, id, request, directoryobject.DefaultGetMemberObjectsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "object_id", "%s was not found", id)
+		}
+		return tf.ErrorDiagF(err, "Retrieving transitive memberships for %s", id)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving transitive memberships for %s", id)
+	}
+
+	objectIds := pointer.From(resp.Model)
+
+	// The getMemberObjects action does not indicate the type of each object, so look them up in batches
+	membershipsByType := map[string][]string{
+		"AdministrativeUnit": make([]string, 0),
+		"DirectoryRole":      make([]string, 0),
+		"Group":              make([]string, 0),
+	}
+	for i := 0; i < len(objectIds); i += getByIdsBatchSize {
+		batch := objectIds[i:min(i+getByIdsBatchSize, len(objectIds))]
+
+		input := directoryobject.ListGetsByIdsRequest{
+			Ids:   pointer.To(batch),
+			Types: &[]string{"administrativeUnit", "directoryRole", "group"},
+		}
+
+		objectsResp, err := client.ListGetsByIds(ctx, input, directoryobject.DefaultListGetsByIdsOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving directory objects for transitive memberships of %s", id)
+		}
+		if objectsResp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving directory objects for transitive memberships of %s", id)
+		}
+
+		for _, object := range *objectsResp.Model {
+			directoryObject := object.DirectoryObject()
+			if directoryObject.Id == nil {
+				return tf.ErrorDiagF(errors.New("API returned directory object with nil object ID"), "Bad API Response")
+			}
+
+			objectType := formatODataType(pointer.From(directoryObject.ODataType))
+			membershipsByType[objectType] = append(membershipsByType[objectType], *directoryObject.Id)
+		}
+	}
+
+	matchedGroupIds := make([]string, 0)
+	if v, ok := d.GetOk("check_group_object_ids"); ok {
+		checkGroupIds := tf.ExpandStringSlice(v.(*pluginsdk.Set).List())
+
+		// The checkMemberGroups action accepts a maximum of 20 group IDs per request
+		for i := 0; i < len(checkGroupIds); i += checkMemberGroupsBatchSize {
+			input := directoryobject.CheckMemberGroupsRequest{
+				GroupIds: pointer.To(checkGroupIds[i:min(i+checkMemberGroupsBatchSize, len(checkGroupIds))]),
+			}
+
+			checkResp, err := client.CheckMemberGroups(ctx, id, input, directoryobject.DefaultCheckMemberGroupsOperationOptions())
+			if err != nil {
+				return tf.ErrorDiagF(err, "Checking group memberships for %s", id)
+			}
+			if checkResp.Model != nil {
+				matchedGroupIds = append(matchedGroupIds, *checkResp.Model...)
+			}
+		}
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(id.DirectoryObjectId + "/" + strings.Join(objectIds, "/") + "/" + strings.Join(matchedGroupIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for transitive membership object IDs")
+	}
+
+	d.SetId(fmt.Sprintf("transitiveMemberOf#%s#%s", base64.URLEncoding.EncodeToString(h.Sum(nil)), id.DirectoryObjectId))
+
+	tf.Set(d, "administrative_unit_object_ids", membershipsByType["AdministrativeUnit"])
+	tf.Set(d, "directory_role_object_ids", membershipsByType["DirectoryRole"])
+	tf.Set(d, "group_object_ids", membershipsByType["Group"])
+	tf.Set(d, "matched_group_object_ids", matchedGroupIds)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
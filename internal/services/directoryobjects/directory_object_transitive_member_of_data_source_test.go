@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectTransitiveMemberOfDataSource struct{}
+
+func TestAccDirectoryObjectTransitiveMemberOfDataSource_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object_transitive_member_of", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DirectoryObjectTransitiveMemberOfDataSource{}.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("matched_group_object_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectTransitiveMemberOfDataSource_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object_transitive_member_of", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DirectoryObjectTransitiveMemberOfDataSource{}.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("administrative_unit_object_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("directory_role_object_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (DirectoryObjectTransitiveMemberOfDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group" "inner" {
+  display_name     = "acctestGroup-%[1]d-Inner"
+  security_enabled = true
+  members = [
+    azuread_user.test.object_id,
+    azuread_service_principal.test.object_id,
+  ]
+}
+
+resource "azuread_group" "outer" {
+  display_name     = "acctestGroup-%[1]d-Outer"
+  security_enabled = true
+  members = [
+    azuread_group.inner.object_id,
+  ]
+}
+
+resource "azuread_group" "other" {
+  display_name     = "acctestGroup-%[1]d-Other"
+  security_enabled = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r DirectoryObjectTransitiveMemberOfDataSource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_object_transitive_member_of" "test" {
+  object_id = azuread_user.test.object_id
+
+  check_group_object_ids = [
+    azuread_group.outer.object_id,
+    azuread_group.other.object_id,
+  ]
+
+  depends_on = [azuread_group.outer]
+}
+`, r.template(data))
+}
+
+func (r DirectoryObjectTransitiveMemberOfDataSource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_object_transitive_member_of" "test" {
+  object_id             = azuread_service_principal.test.object_id
+  security_enabled_only = true
+
+  depends_on = [azuread_group.outer]
+}
+`, r.template(data))
+}
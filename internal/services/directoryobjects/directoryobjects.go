@@ -10,6 +10,14 @@ import (
 	"golang.org/x/text/language"
 )
 
+const (
+	// checkMemberGroupsBatchSize is the maximum number of group IDs accepted by the checkMemberGroups action
+	checkMemberGroupsBatchSize = 20
+
+	// getByIdsBatchSize is the maximum number of object IDs accepted by the getByIds action
+	getByIdsBatchSize = 1000
+)
+
 func formatODataType(in string) string {
 	return cases.Title(language.AmericanEnglish, cases.NoLower).String(strings.TrimPrefix(in, "#microsoft.graph."))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroles/stable/member"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroletemplates/stable/directoryroletemplate"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignmentschedulerequest"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroledefinition"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleeligibilityschedulerequest"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
@@ -17,6 +18,7 @@ import (
 type Client struct {
 	DirectoryObjectClient                         *directoryobject.DirectoryObjectClient
 	DirectoryRoleAssignmentClient                 *directoryroleassignment.DirectoryRoleAssignmentClient
+	DirectoryRoleAssignmentScheduleRequestClient  *directoryroleassignmentschedulerequest.DirectoryRoleAssignmentScheduleRequestClient
 	DirectoryRoleClient                           *directoryrole.DirectoryRoleClient
 	DirectoryRoleDefinitionClient                 *directoryroledefinition.DirectoryRoleDefinitionClient
 	DirectoryRoleEligibilityScheduleRequestClient *directoryroleeligibilityschedulerequest.DirectoryRoleEligibilityScheduleRequestClient
@@ -43,6 +45,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(directoryRoleAssignmentClient.Client)
 
+	directoryRoleAssignmentScheduleRequestClient, err := directoryroleassignmentschedulerequest.NewDirectoryRoleAssignmentScheduleRequestClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directoryRoleAssignmentScheduleRequestClient.Client)
+
 	directoryRoleDefinitionClient, err := directoryroledefinition.NewDirectoryRoleDefinitionClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -70,6 +78,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	return &Client{
 		DirectoryObjectClient:                         directoryObjectClient,
 		DirectoryRoleAssignmentClient:                 directoryRoleAssignmentClient,
+		DirectoryRoleAssignmentScheduleRequestClient:  directoryRoleAssignmentScheduleRequestClient,
 		DirectoryRoleClient:                           directoryRoleClient,
 		DirectoryRoleDefinitionClient:                 directoryRoleDefinitionClient,
 		DirectoryRoleEligibilityScheduleRequestClient: directoryRoleEligibilityScheduleRequestClient,
@@ -4,3 +4,17 @@
 package directoryroles
 
 const directoryRoleMemberResourceName = "azuread_directory_role_member"
+
+const (
+	DirectoryRoleScheduleRequestStatusCanceled                = "Canceled"
+	DirectoryRoleScheduleRequestStatusDenied                  = "Denied"
+	DirectoryRoleScheduleRequestStatusFailed                  = "Failed"
+	DirectoryRoleScheduleRequestStatusGranted                 = "Granted"
+	DirectoryRoleScheduleRequestStatusPendingAdminDecision    = "PendingAdminDecision"
+	DirectoryRoleScheduleRequestStatusPendingApproval         = "PendingApproval"
+	DirectoryRoleScheduleRequestStatusPendingProvisioning     = "PendingProvisioning"
+	DirectoryRoleScheduleRequestStatusPendingScheduleCreation = "PendingScheduleCreation"
+	DirectoryRoleScheduleRequestStatusProvisioned             = "Provisioned"
+	DirectoryRoleScheduleRequestStatusRevoked                 = "Revoked"
+	DirectoryRoleScheduleRequestStatusScheduleCreated         = "ScheduleCreated"
+)
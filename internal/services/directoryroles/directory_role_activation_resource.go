@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignmentschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func directoryRoleActivationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: directoryRoleActivationResourceCreate,
+		ReadContext:   directoryRoleActivationResourceRead,
+		DeleteContext: directoryRoleActivationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"role_definition_id": {
+				Description:  "The template ID (in the case of built-in roles) or object ID (in the case of custom roles) of the directory role to activate",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"principal_id": {
+				Description:  "The object ID of the principal activating the eligible role. Defaults to the authenticated principal",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"directory_scope_id": {
+				Description:  "Identifier of the directory object representing the scope of the eligible assignment",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"duration": {
+				Description:  "How long the activation lasts, formatted as an ISO8601 duration string (e.g. PT2H for 2 hours)",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"justification": {
+				Description:  "Justification for why the role is activated",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ticket_number": {
+				Description:  "The ticket number authorising the activation",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"ticket_system"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ticket_system": {
+				Description:  "The ticket system authorising the activation",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"ticket_number"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"start_date": {
+				Description: "The date that the activation started",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "The status of the activation request",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directoryRoleActivationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	principalId := d.Get("principal_id").(string)
+	if principalId == "" {
+		principalId = meta.(*clients.Client).ObjectID
+	}
+
+	properties := stable.UnifiedRoleAssignmentScheduleRequest{
+		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_SelfActivate),
+		RoleDefinitionId: nullable.Value(roleDefinitionId),
+		PrincipalId:      nullable.Value(principalId),
+		Justification:    nullable.Value(d.Get("justification").(string)),
+		DirectoryScopeId: nullable.Value(d.Get("directory_scope_id").(string)),
+		ScheduleInfo: &stable.RequestSchedule{
+			Expiration: &stable.ExpirationPattern{
+				Duration: nullable.Value(d.Get("duration").(string)),
+				Type:     pointer.To(stable.ExpirationPatternType_AfterDuration),
+			},
+		},
+		TicketInfo: expandDirectoryRoleScheduleTicketInfo(d),
+	}
+
+	resp, err := client.CreateDirectoryRoleAssignmentScheduleRequest(ctx, properties, directoryroleassignmentschedulerequest.DefaultCreateDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating activation request for role %q by principal %q", roleDefinitionId, principalId)
+	}
+
+	request := resp.Model
+	if request == nil || request.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned role activation request ID was nil"), "API Error")
+	}
+
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(*request.Id)
+	d.SetId(id.UnifiedRoleAssignmentScheduleRequestId)
+
+	// Activation is asynchronous, so wait for the role assignment to be provisioned before returning
+	if _, err = directoryRoleAssignmentScheduleRequestWait(ctx, client, id, []string{DirectoryRoleScheduleRequestStatusProvisioned}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be provisioned", id)
+	}
+
+	return directoryRoleActivationResourceRead(ctx, d, meta)
+}
+
+func directoryRoleActivationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	assignmentClient := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(d.Id())
+
+	resp, err := client.GetDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultGetDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	request := resp.Model
+	if request == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
+	}
+
+	status := pointer.From(request.Status)
+	switch status {
+	case DirectoryRoleScheduleRequestStatusCanceled,
+		DirectoryRoleScheduleRequestStatusDenied,
+		DirectoryRoleScheduleRequestStatusFailed,
+		DirectoryRoleScheduleRequestStatusRevoked:
+		log.Printf("[DEBUG] %s is no longer active - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	principalId := request.PrincipalId.GetOrZero()
+	roleDefinitionId := request.RoleDefinitionId.GetOrZero()
+	directoryScopeId := request.DirectoryScopeId.GetOrZero()
+
+	// Once provisioned, the activation only remains in effect for as long as the resulting role assignment exists. The
+	// assignment is removed when the activation expires or is deactivated outside of Terraform.
+	if status == DirectoryRoleScheduleRequestStatusProvisioned {
+		options := directoryroleassignment.ListDirectoryRoleAssignmentsOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("principalId eq '%s' and roleDefinitionId eq '%s'", principalId, roleDefinitionId)),
+		}
+		assignmentsResp, err := assignmentClient.ListDirectoryRoleAssignments(ctx, options)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing role assignments for principal %q", principalId)
+		}
+
+		active := false
+		for _, assignment := range pointer.From(assignmentsResp.Model) {
+			if strings.EqualFold(assignment.DirectoryScopeId.GetOrZero(), directoryScopeId) {
+				active = true
+				break
+			}
+		}
+		if !active {
+			log.Printf("[DEBUG] Role assignment for %s has expired or was removed - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	tf.Set(d, "role_definition_id", roleDefinitionId)
+	tf.Set(d, "principal_id", principalId)
+	tf.Set(d, "directory_scope_id", directoryScopeId)
+	tf.Set(d, "justification", request.Justification.GetOrZero())
+	tf.Set(d, "status", status)
+
+	if scheduleInfo := request.ScheduleInfo; scheduleInfo != nil {
+		tf.Set(d, "start_date", scheduleInfo.StartDateTime.GetOrZero())
+		if scheduleInfo.Expiration != nil {
+			tf.Set(d, "duration", scheduleInfo.Expiration.Duration.GetOrZero())
+		}
+	}
+
+	if ticketInfo := request.TicketInfo; ticketInfo != nil {
+		tf.Set(d, "ticket_number", ticketInfo.TicketNumber.GetOrZero())
+		tf.Set(d, "ticket_system", ticketInfo.TicketSystem.GetOrZero())
+	}
+
+	return nil
+}
+
+func directoryRoleActivationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(d.Id())
+
+	switch d.Get("status").(string) {
+	case DirectoryRoleScheduleRequestStatusGranted,
+		DirectoryRoleScheduleRequestStatusPendingAdminDecision,
+		DirectoryRoleScheduleRequestStatusPendingApproval,
+		DirectoryRoleScheduleRequestStatusPendingProvisioning,
+		DirectoryRoleScheduleRequestStatusPendingScheduleCreation,
+		DirectoryRoleScheduleRequestStatusScheduleCreated:
+		if resp, err := client.CancelDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultCancelDirectoryRoleAssignmentScheduleRequestOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Canceling %s", id)
+		}
+		return nil
+
+	case DirectoryRoleScheduleRequestStatusProvisioned:
+		properties := stable.UnifiedRoleAssignmentScheduleRequest{
+			Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_SelfDeactivate),
+			RoleDefinitionId: nullable.Value(d.Get("role_definition_id").(string)),
+			PrincipalId:      nullable.Value(d.Get("principal_id").(string)),
+			DirectoryScopeId: nullable.Value(d.Get("directory_scope_id").(string)),
+		}
+
+		resp, err := client.CreateDirectoryRoleAssignmentScheduleRequest(ctx, properties, directoryroleassignmentschedulerequest.DefaultCreateDirectoryRoleAssignmentScheduleRequestOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil
+			}
+			return tf.ErrorDiagF(err, "Creating deactivation request for %s", id)
+		}
+
+		if resp.Model == nil || resp.Model.Id == nil {
+			return tf.ErrorDiagF(errors.New("returned role deactivation request ID was nil"), "API Error")
+		}
+
+		deactivationId := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(*resp.Model.Id)
+		if _, err = directoryRoleAssignmentScheduleRequestWait(ctx, client, deactivationId, []string{DirectoryRoleScheduleRequestStatusRevoked}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for deactivation of %s", id)
+		}
+	}
+
+	return nil
+}
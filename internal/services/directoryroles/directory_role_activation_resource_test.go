@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignmentschedulerequest"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type DirectoryRoleActivationResource struct{}
+
+func TestAccDirectoryRoleActivation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_activation", "test")
+	r := DirectoryRoleActivationResource{}
+
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_id").IsUuid(),
+				check.That(data.ResourceName).Key("status").HasValue("Provisioned"),
+				check.That(data.ResourceName).Key("ticket_number").HasValue("1234"),
+				// An activation must be active for at least 5 minutes before it can be deactivated
+				func(*terraform.State) error {
+					time.Sleep(5*time.Minute + 15*time.Second)
+					return nil
+				},
+			),
+		},
+	})
+}
+
+func (r DirectoryRoleActivationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(state.ID)
+
+	resp, err := client.GetDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultGetDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil && pointer.From(resp.Model.Status) == "Provisioned"), nil
+}
+
+func (r DirectoryRoleActivationResource) basic(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+data "azuread_client_config" "current" {}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Reports Reader"
+}
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_definition_id = azuread_directory_role.test.template_id
+  principal_id       = data.azuread_client_config.current.object_id
+  directory_scope_id = "/"
+  justification      = "abc"
+}
+
+resource "azuread_directory_role_activation" "test" {
+  role_definition_id = azuread_directory_role_eligibility_schedule_request.test.role_definition_id
+  duration           = "PT1H"
+  justification      = "acceptance test"
+  ticket_number      = "1234"
+  ticket_system      = "acctest"
+}
+`
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignmentschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func directoryRoleAssignmentScheduleRequestResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: directoryRoleAssignmentScheduleRequestResourceCreate,
+		ReadContext:   directoryRoleAssignmentScheduleRequestResourceRead,
+		UpdateContext: directoryRoleAssignmentScheduleRequestResourceUpdate,
+		DeleteContext: directoryRoleAssignmentScheduleRequestResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: directoryRoleScheduleRequestSchema(),
+	}
+}
+
+func directoryRoleAssignmentScheduleRequestResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	principalId := d.Get("principal_id").(string)
+	justification := d.Get("justification").(string)
+	directoryScopeId := d.Get("directory_scope_id").(string)
+
+	schedule, err := expandDirectoryRoleSchedule(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building schedule for role assignment schedule request")
+	}
+
+	properties := stable.UnifiedRoleAssignmentScheduleRequest{
+		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminAssign),
+		RoleDefinitionId: nullable.Value(roleDefinitionId),
+		PrincipalId:      nullable.Value(principalId),
+		Justification:    nullable.Value(justification),
+		DirectoryScopeId: nullable.Value(directoryScopeId),
+		ScheduleInfo:     schedule,
+		TicketInfo:       expandDirectoryRoleScheduleTicketInfo(d),
+	}
+
+	options := directoryroleassignmentschedulerequest.CreateDirectoryRoleAssignmentScheduleRequestOperationOptions{
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			if response.WasNotFound(resp) && o.Error != nil {
+				return o.Error.Match("RoleNotFound") || o.Error.Match("SubjectNotFound"), nil
+			}
+			return false, nil
+		},
+	}
+
+	resp, err := client.CreateDirectoryRoleAssignmentScheduleRequest(ctx, properties, options)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating assignment schedule request for role %q to principal %q: %+v", roleDefinitionId, principalId, err)
+	}
+
+	roleAssignmentScheduleRequest := resp.Model
+	if roleAssignmentScheduleRequest == nil || roleAssignmentScheduleRequest.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned role assignment schedule request ID was nil"), "API Error")
+	}
+
+	if pointer.From(roleAssignmentScheduleRequest.Status) == DirectoryRoleScheduleRequestStatusFailed {
+		return tf.ErrorDiagF(errors.New("request is in a failed state"), "Creating assignment schedule request for role %q to principal %q", roleDefinitionId, principalId)
+	}
+
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(*roleAssignmentScheduleRequest.Id)
+	d.SetId(id.UnifiedRoleAssignmentScheduleRequestId)
+
+	if _, err = directoryRoleAssignmentScheduleRequestWait(ctx, client, id, []string{DirectoryRoleScheduleRequestStatusProvisioned}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for assignment schedule request for %q to be provisioned for directory role %q", principalId, roleDefinitionId)
+	}
+
+	return directoryRoleAssignmentScheduleRequestResourceRead(ctx, d, meta)
+}
+
+func directoryRoleAssignmentScheduleRequestResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(d.Id())
+
+	schedule, err := expandDirectoryRoleSchedule(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building schedule for %s", id)
+	}
+
+	// Schedule requests cannot be modified, so the existing assignment is updated (or renewed, when it has already
+	// expired) by submitting a new request for the same principal, role and scope
+	properties := stable.UnifiedRoleAssignmentScheduleRequest{
+		Action:           pointer.To(directoryRoleScheduleUpdateAction(d)),
+		RoleDefinitionId: nullable.Value(d.Get("role_definition_id").(string)),
+		PrincipalId:      nullable.Value(d.Get("principal_id").(string)),
+		Justification:    nullable.Value(d.Get("justification").(string)),
+		DirectoryScopeId: nullable.Value(d.Get("directory_scope_id").(string)),
+		ScheduleInfo:     schedule,
+		TicketInfo:       expandDirectoryRoleScheduleTicketInfo(d),
+	}
+
+	resp, err := client.CreateDirectoryRoleAssignmentScheduleRequest(ctx, properties, directoryroleassignmentschedulerequest.DefaultCreateDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating updated assignment schedule request for %s", id)
+	}
+
+	if resp.Model == nil || resp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned role assignment schedule request ID was nil"), "API Error")
+	}
+
+	if pointer.From(resp.Model.Status) == DirectoryRoleScheduleRequestStatusFailed {
+		return tf.ErrorDiagF(errors.New("request is in a failed state"), "Creating updated assignment schedule request for %s", id)
+	}
+
+	updateId := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(*resp.Model.Id)
+	if _, err = directoryRoleAssignmentScheduleRequestWait(ctx, client, updateId, []string{DirectoryRoleScheduleRequestStatusProvisioned}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be provisioned", updateId)
+	}
+
+	return directoryRoleAssignmentScheduleRequestResourceRead(ctx, d, meta)
+}
+
+func directoryRoleAssignmentScheduleRequestResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(d.Id())
+
+	resp, err := client.GetDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultGetDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	roleAssignmentScheduleRequest := resp.Model
+	if roleAssignmentScheduleRequest == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
+	}
+
+	latest, err := directoryRoleAssignmentScheduleRequestLatest(ctx, client, *roleAssignmentScheduleRequest)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving latest request for %s", id)
+	}
+
+	if directoryRoleScheduleRequestInactive(latest.Action, latest.Status) {
+		log.Printf("[DEBUG] %s is no longer active - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "role_definition_id", roleAssignmentScheduleRequest.RoleDefinitionId.GetOrZero())
+	tf.Set(d, "principal_id", roleAssignmentScheduleRequest.PrincipalId.GetOrZero())
+	tf.Set(d, "justification", latest.Justification.GetOrZero())
+	tf.Set(d, "directory_scope_id", roleAssignmentScheduleRequest.DirectoryScopeId.GetOrZero())
+	tf.Set(d, "status", pointer.From(latest.Status))
+
+	flattenDirectoryRoleSchedule(d, latest.ScheduleInfo, latest.TicketInfo)
+
+	return nil
+}
+
+func directoryRoleAssignmentScheduleRequestResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleAssignmentScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(d.Id())
+
+	resp, err := client.GetDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultGetDirectoryRoleAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	roleAssignmentScheduleRequest := resp.Model
+	if roleAssignmentScheduleRequest == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
+	}
+
+	latest, err := directoryRoleAssignmentScheduleRequestLatest(ctx, client, *roleAssignmentScheduleRequest)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving latest request for %s", id)
+	}
+
+	if directoryRoleScheduleRequestInactive(latest.Action, latest.Status) {
+		log.Printf("[DEBUG] %s is no longer active", id)
+		return nil
+	}
+
+	switch pointer.From(latest.Status) {
+	case DirectoryRoleScheduleRequestStatusPendingAdminDecision,
+		DirectoryRoleScheduleRequestStatusPendingApproval,
+		DirectoryRoleScheduleRequestStatusPendingProvisioning,
+		DirectoryRoleScheduleRequestStatusPendingScheduleCreation:
+		latestId := stable.NewRoleManagementDirectoryRoleAssignmentScheduleRequestID(pointer.From(latest.Id))
+		if _, err = client.CancelDirectoryRoleAssignmentScheduleRequest(ctx, latestId, directoryroleassignmentschedulerequest.DefaultCancelDirectoryRoleAssignmentScheduleRequestOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Canceling %s", latestId)
+		}
+
+		// When the pending request is the original request, no schedule has been created. Otherwise the pending request
+		// updates an existing schedule, which remains active after the request is canceled and must still be removed.
+		if latestId.UnifiedRoleAssignmentScheduleRequestId == id.UnifiedRoleAssignmentScheduleRequestId {
+			return nil
+		}
+	}
+
+	properties := stable.UnifiedRoleAssignmentScheduleRequest{
+		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminRemove),
+		RoleDefinitionId: roleAssignmentScheduleRequest.RoleDefinitionId,
+		PrincipalId:      roleAssignmentScheduleRequest.PrincipalId,
+		Justification:    latest.Justification,
+		DirectoryScopeId: roleAssignmentScheduleRequest.DirectoryScopeId,
+	}
+
+	if _, err = client.CreateDirectoryRoleAssignmentScheduleRequest(ctx, properties, directoryroleassignmentschedulerequest.DefaultCreateDirectoryRoleAssignmentScheduleRequestOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Removing role assignment schedule request %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// directoryRoleAssignmentScheduleRequestLatest returns the most recent request which targets the same schedule as the
+// provided request. Schedule requests are never modified or deleted, and new requests are created when a schedule is
+// updated, so the latest request is needed in order to reflect changes made since the original request.
+func directoryRoleAssignmentScheduleRequestLatest(ctx context.Context, client *directoryroleassignmentschedulerequest.DirectoryRoleAssignmentScheduleRequestClient, request stable.UnifiedRoleAssignmentScheduleRequest) (*stable.UnifiedRoleAssignmentScheduleRequest, error) {
+	targetScheduleId := request.TargetScheduleId.GetOrZero()
+	if targetScheduleId == "" {
+		return &request, nil
+	}
+
+	options := directoryroleassignmentschedulerequest.ListDirectoryRoleAssignmentScheduleRequestsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("principalId eq '%s' and roleDefinitionId eq '%s'", request.PrincipalId.GetOrZero(), request.RoleDefinitionId.GetOrZero())),
+	}
+	resp, err := client.ListDirectoryRoleAssignmentScheduleRequests(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := request
+	if resp.Model != nil {
+		for _, item := range *resp.Model {
+			if item.TargetScheduleId.GetOrZero() == targetScheduleId && item.CreatedDateTime.GetOrZero() > latest.CreatedDateTime.GetOrZero() {
+				latest = item
+			}
+		}
+	}
+
+	return &latest, nil
+}
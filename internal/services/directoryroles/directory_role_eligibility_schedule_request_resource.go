@@ -19,21 +19,21 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
-	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
-	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 )
 
 func directoryRoleEligibilityScheduleRequestResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: directoryRoleEligibilityScheduleRequestResourceCreate,
 		ReadContext:   directoryRoleEligibilityScheduleRequestResourceRead,
+		UpdateContext: directoryRoleEligibilityScheduleRequestResourceUpdate,
 		DeleteContext: directoryRoleEligibilityScheduleRequestResourceDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -44,39 +44,7 @@ func directoryRoleEligibilityScheduleRequestResource() *pluginsdk.Resource {
 			return nil
 		}),
 
-		Schema: map[string]*pluginsdk.Schema{
-			"role_definition_id": {
-				Description:  "The object ID of the directory role for this role eligibility schedule request",
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsUUID,
-			},
-
-			"principal_id": {
-				Description:  "The object ID of the member principal",
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsUUID,
-			},
-
-			"directory_scope_id": {
-				Description:  "Identifier of the directory object representing the scope of the role eligibility schedule request",
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-			},
-
-			"justification": {
-				Description:  "Justification for why the role is assigned",
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-			},
-		},
+		Schema: directoryRoleScheduleRequestSchema(),
 	}
 }
 
@@ -88,18 +56,19 @@ func directoryRoleEligibilityScheduleRequestResourceCreate(ctx context.Context,
 	justification := d.Get("justification").(string)
 	directoryScopeId := d.Get("directory_scope_id").(string)
 
+	schedule, err := expandDirectoryRoleSchedule(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building schedule for role eligibility schedule request")
+	}
+
 	properties := stable.UnifiedRoleEligibilityScheduleRequest{
 		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminAssign),
 		RoleDefinitionId: nullable.Value(roleDefinitionId),
 		PrincipalId:      nullable.Value(principalId),
 		Justification:    nullable.Value(justification),
 		DirectoryScopeId: nullable.Value(directoryScopeId),
-		ScheduleInfo: &stable.RequestSchedule{
-			StartDateTime: nullable.Value(time.Now().Format(time.RFC3339)),
-			Expiration: &stable.ExpirationPattern{
-				Type: pointer.To(stable.ExpirationPatternType_NoExpiration),
-			},
-		},
+		ScheduleInfo:     schedule,
+		TicketInfo:       expandDirectoryRoleScheduleTicketInfo(d),
 	}
 
 	options := directoryroleeligibilityschedulerequest.CreateDirectoryRoleEligibilityScheduleRequestOperationOptions{
@@ -121,20 +90,57 @@ func directoryRoleEligibilityScheduleRequestResourceCreate(ctx context.Context,
 		return tf.ErrorDiagF(errors.New("returned role roleEligibilityScheduleRequest ID was nil"), "API Error")
 	}
 
+	if pointer.From(roleEligibilityScheduleRequest.Status) == DirectoryRoleScheduleRequestStatusFailed {
+		return tf.ErrorDiagF(errors.New("request is in a failed state"), "Creating eligibility schedule request for role %q to principal %q", roleDefinitionId, principalId)
+	}
+
 	id := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(*roleEligibilityScheduleRequest.Id)
 	d.SetId(id.UnifiedRoleEligibilityScheduleRequestId)
 
-	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
-		resp, err := client.GetDirectoryRoleEligibilityScheduleRequest(ctx, id, directoryroleeligibilityschedulerequest.DefaultGetDirectoryRoleEligibilityScheduleRequestOperationOptions())
-		if err != nil {
-			if response.WasNotFound(resp.HttpResponse) {
-				return pointer.To(false), nil
-			}
-			return nil, err
-		}
-		return pointer.To(resp.Model != nil), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "Waiting for role eligibility schedule request for %q to be created for directory role %q", principalId, roleDefinitionId)
+	if _, err = directoryRoleEligibilityScheduleRequestWait(ctx, client, id, []string{DirectoryRoleScheduleRequestStatusProvisioned}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for eligibility schedule request for %q to be provisioned for directory role %q", principalId, roleDefinitionId)
+	}
+
+	return directoryRoleEligibilityScheduleRequestResourceRead(ctx, d, meta)
+}
+
+func directoryRoleEligibilityScheduleRequestResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleEligibilityScheduleRequestClient
+	id := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(d.Id())
+
+	schedule, err := expandDirectoryRoleSchedule(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building schedule for %s", id)
+	}
+
+	// Schedule requests cannot be modified, so the existing eligibility is updated (or renewed, when it has already
+	// expired) by submitting a new request for the same principal, role and scope
+	properties := stable.UnifiedRoleEligibilityScheduleRequest{
+		Action:           pointer.To(directoryRoleScheduleUpdateAction(d)),
+		RoleDefinitionId: nullable.Value(d.Get("role_definition_id").(string)),
+		PrincipalId:      nullable.Value(d.Get("principal_id").(string)),
+		Justification:    nullable.Value(d.Get("justification").(string)),
+		DirectoryScopeId: nullable.Value(d.Get("directory_scope_id").(string)),
+		ScheduleInfo:     schedule,
+		TicketInfo:       expandDirectoryRoleScheduleTicketInfo(d),
+	}
+
+	resp, err := client.CreateDirectoryRoleEligibilityScheduleRequest(ctx, properties, directoryroleeligibilityschedulerequest.DefaultCreateDirectoryRoleEligibilityScheduleRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating updated eligibility schedule request for %s", id)
+	}
+
+	if resp.Model == nil || resp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned role roleEligibilityScheduleRequest ID was nil"), "API Error")
+	}
+
+	if pointer.From(resp.Model.Status) == DirectoryRoleScheduleRequestStatusFailed {
+		return tf.ErrorDiagF(errors.New("request is in a failed state"), "Creating updated eligibility schedule request for %s", id)
+	}
+
+	updateId := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(*resp.Model.Id)
+	if _, err = directoryRoleEligibilityScheduleRequestWait(ctx, client, updateId, []string{DirectoryRoleScheduleRequestStatusProvisioned}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be provisioned", updateId)
 	}
 
 	return directoryRoleEligibilityScheduleRequestResourceRead(ctx, d, meta)
@@ -159,10 +165,24 @@ func directoryRoleEligibilityScheduleRequestResourceRead(ctx context.Context, d
 		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
 	}
 
+	latest, err := directoryRoleEligibilityScheduleRequestLatest(ctx, client, *roleEligibilityScheduleRequest)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving latest request for %s", id)
+	}
+
+	if directoryRoleScheduleRequestInactive(latest.Action, latest.Status) {
+		log.Printf("[DEBUG] %s is no longer active - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
 	tf.Set(d, "role_definition_id", roleEligibilityScheduleRequest.RoleDefinitionId.GetOrZero())
 	tf.Set(d, "principal_id", roleEligibilityScheduleRequest.PrincipalId.GetOrZero())
-	tf.Set(d, "justification", roleEligibilityScheduleRequest.Justification.GetOrZero())
+	tf.Set(d, "justification", latest.Justification.GetOrZero())
 	tf.Set(d, "directory_scope_id", roleEligibilityScheduleRequest.DirectoryScopeId.GetOrZero())
+	tf.Set(d, "status", pointer.From(latest.Status))
+
+	flattenDirectoryRoleSchedule(d, latest.ScheduleInfo, latest.TicketInfo)
 
 	return nil
 }
@@ -181,11 +201,38 @@ func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context,
 		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
 	}
 
+	latest, err := directoryRoleEligibilityScheduleRequestLatest(ctx, client, *roleEligibilityScheduleRequest)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving latest request for %s", id)
+	}
+
+	if directoryRoleScheduleRequestInactive(latest.Action, latest.Status) {
+		log.Printf("[DEBUG] %s is no longer active", id)
+		return nil
+	}
+
+	switch pointer.From(latest.Status) {
+	case DirectoryRoleScheduleRequestStatusPendingAdminDecision,
+		DirectoryRoleScheduleRequestStatusPendingApproval,
+		DirectoryRoleScheduleRequestStatusPendingProvisioning,
+		DirectoryRoleScheduleRequestStatusPendingScheduleCreation:
+		latestId := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(pointer.From(latest.Id))
+		if _, err = client.CancelDirectoryRoleEligibilityScheduleRequest(ctx, latestId, directoryroleeligibilityschedulerequest.DefaultCancelDirectoryRoleEligibilityScheduleRequestOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Canceling %s", latestId)
+		}
+
+		// When the pending request is the original request, no schedule has been created. Otherwise the pending request
+		// updates an existing schedule, which remains active after the request is canceled and must still be removed.
+		if latestId.UnifiedRoleEligibilityScheduleRequestId == id.UnifiedRoleEligibilityScheduleRequestId {
+			return nil
+		}
+	}
+
 	properties := stable.UnifiedRoleEligibilityScheduleRequest{
 		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminRemove),
 		RoleDefinitionId: roleEligibilityScheduleRequest.RoleDefinitionId,
 		PrincipalId:      roleEligibilityScheduleRequest.PrincipalId,
-		Justification:    roleEligibilityScheduleRequest.Justification,
+		Justification:    latest.Justification,
 		DirectoryScopeId: roleEligibilityScheduleRequest.DirectoryScopeId,
 	}
 
@@ -195,3 +242,32 @@ func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context,
 
 	return nil
 }
+
+// directoryRoleEligibilityScheduleRequestLatest returns the most recent request which targets the same schedule as the
+// provided request. Schedule requests are never modified or deleted, and new requests are created when a schedule is
+// updated, so the latest request is needed in order to reflect changes made since the original request.
+func directoryRoleEligibilityScheduleRequestLatest(ctx context.Context, client *directoryroleeligibilityschedulerequest.DirectoryRoleEligibilityScheduleRequestClient, request stable.UnifiedRoleEligibilityScheduleRequest) (*stable.UnifiedRoleEligibilityScheduleRequest, error) {
+	targetScheduleId := request.TargetScheduleId.GetOrZero()
+	if targetScheduleId == "" {
+		return &request, nil
+	}
+
+	options := directoryroleeligibilityschedulerequest.ListDirectoryRoleEligibilityScheduleRequestsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("principalId eq '%s' and roleDefinitionId eq '%s'", request.PrincipalId.GetOrZero(), request.RoleDefinitionId.GetOrZero())),
+	}
+	resp, err := client.ListDirectoryRoleEligibilityScheduleRequests(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := request
+	if resp.Model != nil {
+		for _, item := range *resp.Model {
+			if item.TargetScheduleId.GetOrZero() == targetScheduleId && item.CreatedDateTime.GetOrZero() > latest.CreatedDateTime.GetOrZero() {
+				latest = item
+			}
+		}
+	}
+
+	return &latest, nil
+}
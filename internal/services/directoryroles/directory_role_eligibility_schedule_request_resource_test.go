@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -34,6 +35,49 @@ func TestAccRoleEligibilityScheduleRequest_builtin(t *testing.T) {
 	})
 }
 
+func TestAccRoleEligibilityScheduleRequest_expiration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := RoleEligibilityScheduleRequestResource{}
+
+	expiry := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	extendedExpiry := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.expiration(data, expiry),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiration_date").HasValue(expiry),
+				check.That(data.ResourceName).Key("permanent_assignment").HasValue("false"),
+				check.That(data.ResourceName).Key("ticket_number").HasValue("1234"),
+			),
+		},
+		{
+			Config: r.expiration(data, extendedExpiry),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiration_date").HasValue(extendedExpiry),
+			),
+		},
+	})
+}
+
+func TestAccRoleEligibilityScheduleRequest_duration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := RoleEligibilityScheduleRequestResource{}
+
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.duration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("duration").HasValue("P30D"),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
 func (r RoleEligibilityScheduleRequestResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.DirectoryRoles.DirectoryRoleEligibilityScheduleRequestClient
 	id := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(state.ID)
@@ -75,3 +119,53 @@ resource "azuread_directory_role_eligibility_schedule_request" "test" {
 }
 `, data.RandomInteger, data.RandomPassword)
 }
+
+func (r RoleEligibilityScheduleRequestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestManager.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestManager-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Application Administrator"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r RoleEligibilityScheduleRequestResource) expiration(data acceptance.TestData, expiry string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_definition_id = azuread_directory_role.test.template_id
+  principal_id       = azuread_user.test.object_id
+  directory_scope_id = "/"
+  justification      = "abc"
+  expiration_date    = "%[2]s"
+  ticket_number      = "1234"
+  ticket_system      = "acctest"
+}
+`, r.template(data), expiry)
+}
+
+func (r RoleEligibilityScheduleRequestResource) duration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_definition_id = azuread_directory_role.test.template_id
+  principal_id       = azuread_user.test.object_id
+  directory_scope_id = "/"
+  justification      = "abc"
+  duration           = "P30D"
+}
+`, r.template(data))
+}
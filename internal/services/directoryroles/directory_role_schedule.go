@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleassignmentschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/stable/directoryroleeligibilityschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// directoryRoleScheduleRequestSchema returns the schema which is shared by directory role assignment and eligibility
+// schedule requests
+func directoryRoleScheduleRequestSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role_definition_id": {
+			Description:  "The object ID of the directory role for this schedule request",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"principal_id": {
+			Description:  "The object ID of the member principal",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"directory_scope_id": {
+			Description:  "Identifier of the directory object representing the scope of the schedule request",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"justification": {
+			Description:  "Justification for why the role is assigned",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_date": {
+			Description:           "The date that this schedule starts, formatted as an RFC3339 date string in UTC (e.g. 2018-01-01T01:02:03Z)",
+			Type:                  pluginsdk.TypeString,
+			Optional:              true,
+			Computed:              true,
+			ValidateFunc:          validation.IsRFC3339Time,
+			DiffSuppressOnRefresh: true,
+			DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+				// Suppress diffs if the start date is in the past
+				oldTime, err := time.Parse(time.RFC3339, old)
+				if err == nil {
+					return oldTime.Before(time.Now())
+				}
+				return false
+			},
+		},
+
+		"expiration_date": {
+			Description:   "The date that this schedule expires, formatted as an RFC3339 date string in UTC (e.g. 2018-01-01T01:02:03Z)",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"duration", "permanent_assignment"},
+			ValidateFunc:  validation.IsRFC3339Time,
+		},
+
+		"duration": {
+			Description:   "The duration of the schedule, formatted as an ISO8601 duration string (e.g. P3D for 3 days)",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"expiration_date", "permanent_assignment"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"permanent_assignment": {
+			Description:   "Whether the schedule is permanent and never expires",
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"duration", "expiration_date"},
+		},
+
+		"ticket_number": {
+			Description:  "The ticket number authorising the request",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"ticket_system"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ticket_system": {
+			Description:  "The ticket system authorising the request",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"ticket_number"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"status": {
+			Description: "The status of the schedule request",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// expandDirectoryRoleSchedule builds the schedule for a directory role schedule request. When neither `expiration_date`
+// nor `duration` is specified, the schedule does not expire.
+func expandDirectoryRoleSchedule(d *pluginsdk.ResourceData) (*stable.RequestSchedule, error) {
+	schedule := stable.RequestSchedule{
+		Expiration: &stable.ExpirationPattern{},
+	}
+
+	startDate := time.Now()
+	if v := d.Get("start_date").(string); v != "" {
+		var err error
+		if startDate, err = time.Parse(time.RFC3339, v); err != nil {
+			return nil, fmt.Errorf("parsing `start_date` %q: %+v", v, err)
+		}
+	}
+
+	// A start date in the past, such as the original start date of an existing schedule, is replaced with the current time
+	if startDate.Before(time.Now()) {
+		startDate = time.Now()
+	}
+	schedule.StartDateTime = nullable.Value(startDate.UTC().Format(time.RFC3339))
+
+	permanent := false
+	if v := d.GetRawConfig().GetAttr("permanent_assignment"); v.IsKnown() && !v.IsNull() {
+		permanent = v.True()
+	}
+
+	switch {
+	case d.Get("duration").(string) != "":
+		schedule.Expiration.Duration = nullable.Value(d.Get("duration").(string))
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_AfterDuration)
+
+	case !permanent && d.Get("expiration_date").(string) != "":
+		expirationDate := d.Get("expiration_date").(string)
+		expiryDate, err := time.Parse(time.RFC3339, expirationDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `expiration_date` %q: %+v", expirationDate, err)
+		}
+
+		if expiryDate.Before(startDate.Add(5 * time.Minute)) {
+			return nil, errors.New("`expiration_date` must be at least 5 minutes after `start_date`")
+		}
+
+		schedule.Expiration.EndDateTime = nullable.Value(expirationDate)
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_AfterDateTime)
+
+	default:
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_NoExpiration)
+	}
+
+	return &schedule, nil
+}
+
+// expandDirectoryRoleScheduleTicketInfo returns the ticket information for a directory role schedule request, or nil
+// when no ticket information is specified
+func expandDirectoryRoleScheduleTicketInfo(d *pluginsdk.ResourceData) *stable.TicketInfo {
+	ticketNumber := d.Get("ticket_number").(string)
+	ticketSystem := d.Get("ticket_system").(string)
+
+	if ticketNumber == "" && ticketSystem == "" {
+		return nil
+	}
+
+	return &stable.TicketInfo{
+		TicketNumber: nullable.NoZero(ticketNumber),
+		TicketSystem: nullable.NoZero(ticketSystem),
+	}
+}
+
+// directoryRoleScheduleUpdateAction returns the action to use when updating an existing schedule in place. Schedules which
+// have already expired must be renewed, whereas active or future schedules are updated.
+func directoryRoleScheduleUpdateAction(d *pluginsdk.ResourceData) stable.UnifiedRoleScheduleRequestActions {
+	oldExpirationDate, _ := d.GetChange("expiration_date")
+	if v, ok := oldExpirationDate.(string); ok && v != "" {
+		if expiryDate, err := time.Parse(time.RFC3339, v); err == nil && expiryDate.Before(time.Now()) {
+			return stable.UnifiedRoleScheduleRequestActions_AdminRenew
+		}
+	}
+
+	return stable.UnifiedRoleScheduleRequestActions_AdminUpdate
+}
+
+// flattenDirectoryRoleSchedule sets the schedule and ticket properties in state
+func flattenDirectoryRoleSchedule(d *pluginsdk.ResourceData, scheduleInfo *stable.RequestSchedule, ticketInfo *stable.TicketInfo) {
+	if scheduleInfo != nil {
+		tf.Set(d, "start_date", scheduleInfo.StartDateTime.GetOrZero())
+
+		if expiration := scheduleInfo.Expiration; expiration != nil {
+			duration := ""
+			if pointer.From(expiration.Type) == stable.ExpirationPatternType_AfterDuration {
+				duration = expiration.Duration.GetOrZero()
+			}
+
+			tf.Set(d, "duration", duration)
+			tf.Set(d, "expiration_date", expiration.EndDateTime.GetOrZero())
+			tf.Set(d, "permanent_assignment", pointer.From(expiration.Type) == stable.ExpirationPatternType_NoExpiration)
+		}
+	}
+
+	if ticketInfo != nil {
+		tf.Set(d, "ticket_number", ticketInfo.TicketNumber.GetOrZero())
+		tf.Set(d, "ticket_system", ticketInfo.TicketSystem.GetOrZero())
+	}
+}
+
+// directoryRoleScheduleRequestInactive returns whether the latest request for a schedule indicates that the schedule no
+// longer exists, either because it was removed or because the request did not complete
+func directoryRoleScheduleRequestInactive(action *stable.UnifiedRoleScheduleRequestActions, status *string) bool {
+	if pointer.From(action) == stable.UnifiedRoleScheduleRequestActions_AdminRemove {
+		return true
+	}
+
+	switch pointer.From(status) {
+	case DirectoryRoleScheduleRequestStatusCanceled,
+		DirectoryRoleScheduleRequestStatusDenied,
+		DirectoryRoleScheduleRequestStatusFailed,
+		DirectoryRoleScheduleRequestStatusRevoked:
+		return true
+	}
+
+	return false
+}
+
+// directoryRoleAssignmentScheduleRequestWait waits for an assignment schedule request to reach one of the target statuses,
+// returning an error if the request fails, is denied, or is canceled or revoked before reaching a target status
+func directoryRoleAssignmentScheduleRequestWait(ctx context.Context, client *directoryroleassignmentschedulerequest.DirectoryRoleAssignmentScheduleRequestClient, id stable.RoleManagementDirectoryRoleAssignmentScheduleRequestId, target []string) (*stable.UnifiedRoleAssignmentScheduleRequest, error) {
+	result, err := consistency.WaitForScheduleRequestStatus(ctx, target, func(ctx context.Context) (interface{}, *string, error) {
+		resp, err := client.GetDirectoryRoleAssignmentScheduleRequest(ctx, id, directoryroleassignmentschedulerequest.DefaultGetDirectoryRoleAssignmentScheduleRequestOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+
+		request := resp.Model
+		if request == nil || request.Status == nil {
+			return nil, nil, fmt.Errorf("retrieving %s: model or status was nil", id)
+		}
+
+		return request, request.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, ok := result.(*stable.UnifiedRoleAssignmentScheduleRequest)
+	if !ok || request == nil {
+		return nil, fmt.Errorf("unexpected result waiting for %s", id)
+	}
+
+	return request, nil
+}
+
+// directoryRoleEligibilityScheduleRequestWait waits for an eligibility schedule request to reach one of the target statuses,
+// returning an error if the request fails, is denied, or is canceled or revoked before reaching a target status
+func directoryRoleEligibilityScheduleRequestWait(ctx context.Context, client *directoryroleeligibilityschedulerequest.DirectoryRoleEligibilityScheduleRequestClient, id stable.RoleManagementDirectoryRoleEligibilityScheduleRequestId, target []string) (*stable.UnifiedRoleEligibilityScheduleRequest, error) {
+	result, err := consistency.WaitForScheduleRequestStatus(ctx, target, func(ctx context.Context) (interface{}, *string, error) {
+		resp, err := client.GetDirectoryRoleEligibilityScheduleRequest(ctx, id, directoryroleeligibilityschedulerequest.DefaultGetDirectoryRoleEligibilityScheduleRequestOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+
+		request := resp.Model
+		if request == nil || request.Status == nil {
+			return nil, nil, fmt.Errorf("retrieving %s: model or status was nil", id)
+		}
+
+		return request, request.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, ok := result.(*stable.UnifiedRoleEligibilityScheduleRequest)
+	if !ok || request == nil {
+		return nil, fmt.Errorf("unexpected result waiting for %s", id)
+	}
+
+	return request, nil
+}
@@ -11,7 +11,11 @@ import (
 	memberofBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/memberof"
 	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
 	transitivememberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/transitivemember"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsetting"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsettingtemplate"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/tenantgrouplifecyclepolicy"
 )
 
 // Note: Whilst it is technically possible that we could use both the Stable and Beta APIs for groups (retaining use of
@@ -22,10 +26,14 @@ type Client struct {
 	AdministrativeUnitMemberClientBeta *administrativeunitmemberBeta.AdministrativeUnitMemberClient
 	DirectoryObjectClient              *directoryobject.DirectoryObjectClient
 	GroupClientBeta                    *groupBeta.GroupClient
+	GroupLifecyclePolicyClient         *grouplifecyclepolicy.GroupLifecyclePolicyClient
 	GroupMemberClientBeta              *memberBeta.MemberClient
 	GroupMemberOfClientBeta            *memberofBeta.MemberOfClient
 	GroupOwnerClientBeta               *ownerBeta.OwnerClient
+	GroupSettingClient                 *groupsetting.GroupSettingClient
+	GroupSettingTemplateClient         *groupsettingtemplate.GroupSettingTemplateClient
 	GroupTransitiveMemberClientBeta    *transitivememberBeta.TransitiveMemberClient
+	TenantGroupLifecyclePolicyClient   *tenantgrouplifecyclepolicy.TenantGroupLifecyclePolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -48,6 +56,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(groupClientBeta.Client)
 
+	groupLifecyclePolicyClient, err := grouplifecyclepolicy.NewGroupLifecyclePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupLifecyclePolicyClient.Client)
+
+	groupSettingClient, err := groupsetting.NewGroupSettingClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupSettingClient.Client)
+
+	groupSettingTemplateClient, err := groupsettingtemplate.NewGroupSettingTemplateClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupSettingTemplateClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/hashicorp/terraform-provider-azuread/issues/1018
 	memberClientBeta, err := memberBeta.NewMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -74,13 +100,23 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(transitiveMemberClientBeta.Client)
 
+	tenantGroupLifecyclePolicyClient, err := tenantgrouplifecyclepolicy.NewTenantGroupLifecyclePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(tenantGroupLifecyclePolicyClient.Client)
+
 	return &Client{
 		AdministrativeUnitMemberClientBeta: administrativeUnitMemberClientBeta,
 		DirectoryObjectClient:              directoryObjectClient,
 		GroupClientBeta:                    groupClientBeta,
+		GroupLifecyclePolicyClient:         groupLifecyclePolicyClient,
 		GroupMemberClientBeta:              memberClientBeta,
 		GroupMemberOfClientBeta:            memberOfClientBeta,
 		GroupOwnerClientBeta:               ownerClientBeta,
+		GroupSettingClient:                 groupSettingClient,
+		GroupSettingTemplateClient:         groupSettingTemplateClient,
 		GroupTransitiveMemberClientBeta:    transitiveMemberClientBeta,
+		TenantGroupLifecyclePolicyClient:   tenantGroupLifecyclePolicyClient,
 	}, nil
 }
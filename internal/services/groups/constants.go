@@ -80,3 +80,31 @@ const (
 )
 
 var possibleValuesForOnPremisesGroupType = []string{OnPremisesGroupTypeUniversalDistributionGroup, OnPremisesGroupTypeUniversalMailEnabledSecurityGroup, OnPremisesGroupTypeUniversalSecurityGroup}
+
+const (
+	MemberObjectTypeDevice           = "Device"
+	MemberObjectTypeGroup            = "Group"
+	MemberObjectTypeServicePrincipal = "ServicePrincipal"
+	MemberObjectTypeUser             = "User"
+)
+
+var possibleValuesForMemberObjectType = []string{MemberObjectTypeDevice, MemberObjectTypeGroup, MemberObjectTypeServicePrincipal, MemberObjectTypeUser}
+
+const (
+	GroupLifecyclePolicyManagedGroupTypesAll      = "All"
+	GroupLifecyclePolicyManagedGroupTypesNone     = "None"
+	GroupLifecyclePolicyManagedGroupTypesSelected = "Selected"
+)
+
+var possibleValuesForGroupLifecyclePolicyManagedGroupTypes = []string{
+	GroupLifecyclePolicyManagedGroupTypesAll,
+	GroupLifecyclePolicyManagedGroupTypesNone,
+	GroupLifecyclePolicyManagedGroupTypesSelected,
+}
+
+const (
+	DirectorySettingValueTypeBoolean = "System.Boolean"
+	DirectorySettingValueTypeGuid    = "System.Guid"
+	DirectorySettingValueTypeInt32   = "System.Int32"
+	DirectorySettingValueTypeString  = "System.String"
+)
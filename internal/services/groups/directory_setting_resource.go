@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsetting"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsettingtemplate"
+)
+
+const directorySettingResourceName = "azuread_directory_setting"
+
+func directorySettingResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: directorySettingResourceCreate,
+		ReadContext:   directorySettingResourceRead,
+		UpdateContext: directorySettingResourceUpdate,
+		DeleteContext: directorySettingResourceDelete,
+
+		CustomizeDiff: directorySettingResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := groupsetting.ValidateGroupSettingID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"template_id": {
+				Description:  "The ID of the directory setting template on which these settings are based",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_id", "template_display_name"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"template_display_name": {
+				Description:  "The display name of the directory setting template on which these settings are based",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_id", "template_display_name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"values": {
+				Description: "A mapping of setting names to values. Settings not specified will be set to the default value declared in the template",
+				Type:        pluginsdk.TypeMap,
+				Required:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"display_name": {
+				Description: "The display name of the directory setting, which is inherited from the template",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directorySettingResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Groups.GroupSettingTemplateClient
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	templateId := diff.Get("template_id").(string)
+	templateDisplayName := diff.Get("template_display_name").(string)
+	if templateId == "" && templateDisplayName == "" {
+		// Template is not yet known
+		return nil
+	}
+
+	if !diff.NewValueKnown("values") {
+		return nil
+	}
+
+	template, err := directorySettingTemplateFind(ctx, client, templateId, templateDisplayName)
+	if err != nil {
+		return err
+	}
+	if template == nil {
+		return fmt.Errorf("no directory setting template was found matching the specified `template_id` or `template_display_name`")
+	}
+
+	templateValues := make(map[string]stable.SettingTemplateValue)
+	for _, v := range pointer.From(template.Values) {
+		templateValues[v.Name.GetOrZero()] = v
+	}
+
+	for name, value := range diff.Get("values").(map[string]interface{}) {
+		templateValue, ok := templateValues[name]
+		if !ok {
+			validNames := make([]string, 0, len(templateValues))
+			for n := range templateValues {
+				validNames = append(validNames, n)
+			}
+			return fmt.Errorf("setting %q is not declared in the directory setting template %q, valid settings are: %s", name, template.DisplayName.GetOrZero(), strings.Join(validNames, ", "))
+		}
+
+		if err = directorySettingValidateValue(templateValue.Type.GetOrZero(), value.(string)); err != nil {
+			return fmt.Errorf("invalid value for setting %q: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func directorySettingResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+	templateClient := meta.(*clients.Client).Groups.GroupSettingTemplateClient
+
+	template, err := directorySettingTemplateFind(ctx, templateClient, d.Get("template_id").(string), d.Get("template_display_name").(string))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving directory setting template")
+	}
+	if template == nil || template.Id == nil {
+		return tf.ErrorDiagF(errors.New("template was not found"), "Retrieving directory setting template")
+	}
+
+	templateId := groupsettingtemplate.NewGroupSettingTemplateID(*template.Id)
+
+	tf.LockByName(directorySettingResourceName, templateId.GroupSettingTemplateId)
+	defer tf.UnlockByName(directorySettingResourceName, templateId.GroupSettingTemplateId)
+
+	// Only a single directory setting can be created from each template
+	existingResp, err := client.ListGroupSettings(ctx, groupsetting.DefaultListGroupSettingsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing directory settings")
+	}
+	if existingResp.Model != nil {
+		for _, existing := range *existingResp.Model {
+			if existing.Id != nil && strings.EqualFold(existing.TemplateId.GetOrZero(), templateId.GroupSettingTemplateId) {
+				return tf.ImportAsExistsDiag(directorySettingResourceName, groupsetting.NewGroupSettingID(*existing.Id).ID())
+			}
+		}
+	}
+
+	// Start with the template defaults, then apply the configured values
+	values := make(map[string]string)
+	for _, v := range pointer.From(template.Values) {
+		values[v.Name.GetOrZero()] = v.DefaultValue.GetOrZero()
+	}
+	for name, value := range d.Get("values").(map[string]interface{}) {
+		values[name] = value.(string)
+	}
+
+	properties := stable.GroupSetting{
+		TemplateId: nullable.Value(templateId.GroupSettingTemplateId),
+		Values:     expandDirectorySettingValues(values),
+	}
+
+	resp, err := client.CreateGroupSetting(ctx, properties, groupsetting.DefaultCreateGroupSettingOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating directory setting from %s", templateId)
+	}
+
+	setting := resp.Model
+	if setting == nil || setting.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned model or ID was nil"), "Creating directory setting from %s", templateId)
+	}
+
+	id := groupsetting.NewGroupSettingID(*setting.Id)
+	d.SetId(id.ID())
+
+	// Wait for the setting to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetGroupSetting(ctx, id, groupsetting.DefaultGetGroupSettingOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return directorySettingResourceRead(ctx, d, meta)
+}
+
+func directorySettingResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+	templateClient := meta.(*clients.Client).Groups.GroupSettingTemplateClient
+
+	id, err := groupsetting.ParseGroupSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	template, err := directorySettingTemplateFind(ctx, templateClient, d.Get("template_id").(string), "")
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving directory setting template for %s", id)
+	}
+	if template == nil {
+		return tf.ErrorDiagF(errors.New("template was not found"), "Retrieving directory setting template for %s", id)
+	}
+
+	// Settings removed from the configuration are reverted to the template defaults
+	values := make(map[string]string)
+	for _, v := range pointer.From(template.Values) {
+		values[v.Name.GetOrZero()] = v.DefaultValue.GetOrZero()
+	}
+	for name, value := range d.Get("values").(map[string]interface{}) {
+		values[name] = value.(string)
+	}
+
+	properties := stable.GroupSetting{
+		Values: expandDirectorySettingValues(values),
+	}
+
+	if _, err = client.UpdateGroupSetting(ctx, *id, properties, groupsetting.DefaultUpdateGroupSettingOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return directorySettingResourceRead(ctx, d, meta)
+}
+
+func directorySettingResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+	templateClient := meta.(*clients.Client).Groups.GroupSettingTemplateClient
+
+	id, err := groupsetting.ParseGroupSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetGroupSetting(ctx, *id, groupsetting.DefaultGetGroupSettingOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	setting := resp.Model
+	if setting == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	templateDisplayName := ""
+	if templateId := setting.TemplateId.GetOrZero(); templateId != "" {
+		templateResp, err := templateClient.GetGroupSettingTemplate(ctx, groupsettingtemplate.NewGroupSettingTemplateID(templateId), groupsettingtemplate.DefaultGetGroupSettingTemplateOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving directory setting template for %s", id)
+		}
+		if templateResp.Model != nil {
+			templateDisplayName = templateResp.Model.DisplayName.GetOrZero()
+		}
+	}
+
+	// Only track settings that are present in the configuration, unless none are known (e.g. when importing)
+	configuredValues := d.Get("values").(map[string]interface{})
+	values := make(map[string]string)
+	for _, v := range pointer.From(setting.Values) {
+		name := v.Name.GetOrZero()
+		if _, ok := configuredValues[name]; ok || len(configuredValues) == 0 {
+			values[name] = v.Value.GetOrZero()
+		}
+	}
+
+	tf.Set(d, "display_name", setting.DisplayName.GetOrZero())
+	tf.Set(d, "template_display_name", templateDisplayName)
+	tf.Set(d, "template_id", setting.TemplateId.GetOrZero())
+	tf.Set(d, "values", values)
+
+	return nil
+}
+
+func directorySettingResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := groupsetting.ParseGroupSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err = client.DeleteGroupSetting(ctx, *id, groupsetting.DefaultDeleteGroupSettingOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	// Wait for setting object to be deleted
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetGroupSetting(ctx, *id, groupsetting.DefaultGetGroupSettingOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsetting"
+)
+
+type DirectorySettingResource struct{}
+
+func TestAccDirectorySetting_groupUnified(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.groupUnified(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_id").IsUuid(),
+				check.That(data.ResourceName).Key("display_name").HasValue("Group.Unified"),
+				check.That(data.ResourceName).Key("values.%").HasValue("2"),
+			),
+		},
+		data.ImportStep("values"),
+		{
+			Config: r.groupUnifiedUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("values"),
+	})
+}
+
+func TestAccDirectorySetting_passwordRuleSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.passwordRuleSettings(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_display_name").HasValue("Password Rule Settings"),
+			),
+		},
+		data.ImportStep("values"),
+	})
+}
+
+func TestAccDirectorySetting_invalidValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidValue(data),
+			ExpectError: regexp.MustCompile("expected a boolean value"),
+		},
+	})
+}
+
+func (r DirectorySettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupSettingClient
+
+	id, err := groupsetting.ParseGroupSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetGroupSetting(ctx, *id, groupsetting.DefaultGetGroupSettingOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DirectorySettingResource) groupUnified(_ acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_display_name = "Group.Unified"
+
+  values = {
+    AllowGuestsToAccessGroups     = "false"
+    PrefixSuffixNamingRequirement = "GRP-[GroupName]"
+  }
+}
+`
+}
+
+func (DirectorySettingResource) groupUnifiedUpdated(_ acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_display_name = "Group.Unified"
+
+  values = {
+    AllowGuestsToAccessGroups     = "true"
+    EnableGroupCreation           = "false"
+    PrefixSuffixNamingRequirement = "GRP-[GroupName]-[Department]"
+  }
+}
+`
+}
+
+func (DirectorySettingResource) passwordRuleSettings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_directory_setting" "test" {
+  template_display_name = "Password Rule Settings"
+
+  values = {
+    BannedPasswordCheckOnPremisesMode = "Audit"
+    EnableBannedPasswordCheck         = "true"
+    BannedPasswordList                = "acctest%[1]d"
+    LockoutDurationInSeconds          = "90"
+    LockoutThreshold                  = "15"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (DirectorySettingResource) invalidValue(_ acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_display_name = "Group.Unified"
+
+  values = {
+    EnableGroupCreation = "maybe"
+  }
+}
+`
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/tenantgrouplifecyclepolicy"
+)
+
+func groupLifecyclePolicyAssociationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLifecyclePolicyAssociationResourceCreate,
+		ReadContext:   groupLifecyclePolicyAssociationResourceRead,
+		DeleteContext: groupLifecyclePolicyAssociationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GroupLifecyclePolicyAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_lifecycle_policy_id": {
+				Description:  "The ID of the group lifecycle policy, in the format `/groupLifecyclePolicies/{policyId}`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tenantgrouplifecyclepolicy.ValidateGroupLifecyclePolicyID,
+			},
+
+			"group_object_id": {
+				Description:  "The object ID of the group to add to the lifecycle policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyAssociationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+	groupPolicyClient := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	policyId, err := tenantgrouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Get("group_lifecycle_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "group_lifecycle_policy_id", "Parsing group lifecycle policy ID")
+	}
+
+	groupId := stable.NewGroupID(d.Get("group_object_id").(string))
+	id := parse.NewGroupLifecyclePolicyAssociationID(policyId.GroupLifecyclePolicyId, groupId.GroupId)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	if resp, err := client.GetGroupLifecyclePolicy(ctx, *policyId, tenantgrouplifecyclepolicy.DefaultGetGroupLifecyclePolicyOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_lifecycle_policy_id", "%s was not found", policyId)
+		}
+		return tf.ErrorDiagPathF(err, "group_lifecycle_policy_id", "Retrieving %s", policyId)
+	}
+
+	existing, err := groupGetLifecyclePolicy(ctx, groupPolicyClient, groupId, policyId.GroupLifecyclePolicyId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing lifecycle policy association for %s", groupId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_group_lifecycle_policy_association", id.String())
+	}
+
+	input := grouplifecyclepolicy.AddGroupLifecyclePolicyGroupRequest{
+		GroupId: pointer.To(groupId.GroupId),
+	}
+
+	resp, err := client.AddGroupLifecyclePolicyGroup(ctx, *policyId, input, tenantgrouplifecyclepolicy.DefaultAddGroupLifecyclePolicyGroupOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagF(err, "Adding %s to %s", groupId, policyId)
+	}
+	if resp.Model != nil && !pointer.From(resp.Model.Value) {
+		return tf.ErrorDiagF(fmt.Errorf("the API did not add the group to the policy; ensure that `managed_group_types` for the policy is set to %q", GroupLifecyclePolicyManagedGroupTypesSelected), "Adding %s to %s", groupId, policyId)
+	}
+
+	d.SetId(id.String())
+
+	// Wait for the association to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		policy, err := groupGetLifecyclePolicy(ctx, groupPolicyClient, groupId, policyId.GroupLifecyclePolicyId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(policy != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be added to %s", groupId, policyId)
+	}
+
+	return groupLifecyclePolicyAssociationResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyAssociationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssociationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy Association ID %q", d.Id())
+	}
+
+	groupId := stable.NewGroupID(id.GroupId)
+
+	policy, err := groupGetLifecyclePolicy(ctx, client, groupId, id.GroupLifecyclePolicyId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving lifecycle policies for %s", groupId)
+	} else if policy == nil {
+		log.Printf("[DEBUG] Group Lifecycle Policy Association %q was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "group_lifecycle_policy_id", tenantgrouplifecyclepolicy.NewGroupLifecyclePolicyID(id.GroupLifecyclePolicyId).ID())
+	tf.Set(d, "group_object_id", id.GroupId)
+
+	return nil
+}
+
+func groupLifecyclePolicyAssociationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+	groupPolicyClient := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssociationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy Association ID %q", d.Id())
+	}
+
+	policyId := tenantgrouplifecyclepolicy.NewGroupLifecyclePolicyID(id.GroupLifecyclePolicyId)
+	groupId := stable.NewGroupID(id.GroupId)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	input := grouplifecyclepolicy.RemoveGroupLifecyclePolicyGroupRequest{
+		GroupId: pointer.To(groupId.GroupId),
+	}
+
+	if resp, err := client.RemoveGroupLifecyclePolicyGroup(ctx, policyId, input, tenantgrouplifecyclepolicy.DefaultRemoveGroupLifecyclePolicyGroupOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s from %s", groupId, policyId)
+	}
+
+	// Wait for the association to be removed
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		policy, err := groupGetLifecyclePolicy(ctx, groupPolicyClient, groupId, id.GroupLifecyclePolicyId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(policy != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s from %s", groupId, policyId)
+	}
+
+	return nil
+}
+
+// groupGetLifecyclePolicy returns the lifecycle policy with the specified ID if it applies to the group, or nil if it does not.
+func groupGetLifecyclePolicy(ctx context.Context, client *grouplifecyclepolicy.GroupLifecyclePolicyClient, groupId stable.GroupId, policyId string) (*stable.GroupLifecyclePolicy, error) {
+	resp, err := client.ListGroupLifecyclePolicies(ctx, groupId, grouplifecyclepolicy.DefaultListGroupLifecyclePoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model != nil {
+		for _, policy := range *resp.Model {
+			if strings.EqualFold(pointer.From(policy.Id), policyId) {
+				return &policy, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
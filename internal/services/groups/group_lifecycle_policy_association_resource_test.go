@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLifecyclePolicyAssociationResource struct{}
+
+func TestAccGroupLifecyclePolicyAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_association", "test")
+	r := GroupLifecyclePolicyAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_lifecycle_policy_id").Exists(),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicyAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_association", "test")
+	r := GroupLifecyclePolicyAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssociationID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group Lifecycle Policy Association ID: %v", err)
+	}
+
+	resp, err := client.ListGroupLifecyclePolicies(ctx, stable.NewGroupID(id.GroupId), grouplifecyclepolicy.DefaultListGroupLifecyclePoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve lifecycle policies for group %q: %+v", id.GroupId, err)
+	}
+
+	if resp.Model != nil {
+		for _, policy := range *resp.Model {
+			if strings.EqualFold(pointer.From(policy.Id), id.GroupLifecyclePolicyId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (GroupLifecyclePolicyAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group_lifecycle_policy" "test" {
+  lifetime_in_days    = 180
+  managed_group_types = "Selected"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_group_lifecycle_policy_association" "test" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy.test.id
+  group_object_id           = azuread_group.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (r GroupLifecyclePolicyAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_lifecycle_policy_association" "import" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy_association.test.group_lifecycle_policy_id
+  group_object_id           = azuread_group_lifecycle_policy_association.test.group_object_id
+}
+`, r.basic(data))
+}
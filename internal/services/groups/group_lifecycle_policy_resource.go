@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/tenantgrouplifecyclepolicy"
+)
+
+const groupLifecyclePolicyResourceName = "azuread_group_lifecycle_policy"
+
+func groupLifecyclePolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLifecyclePolicyResourceCreate,
+		ReadContext:   groupLifecyclePolicyResourceRead,
+		UpdateContext: groupLifecyclePolicyResourceUpdate,
+		DeleteContext: groupLifecyclePolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := tenantgrouplifecyclepolicy.ValidateGroupLifecyclePolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"lifetime_in_days": {
+				Description:  "The number of days before a group expires and needs to be renewed",
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+
+			"managed_group_types": {
+				Description:  "The group types for which the expiration policy applies",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForGroupLifecyclePolicyManagedGroupTypes, false),
+			},
+
+			"alternate_notification_emails": {
+				Description: "A list of email addresses to send notifications to for groups without owners",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+
+	tf.LockByName(groupLifecyclePolicyResourceName, "tenant")
+	defer tf.UnlockByName(groupLifecyclePolicyResourceName, "tenant")
+
+	// Only a single group lifecycle policy can exist in a tenant
+	resp, err := client.ListGroupLifecyclePolicies(ctx, tenantgrouplifecyclepolicy.DefaultListGroupLifecyclePoliciesOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing group lifecycle policy")
+	}
+	if resp.Model != nil {
+		for _, existing := range *resp.Model {
+			if existing.Id != nil {
+				return tf.ImportAsExistsDiag(groupLifecyclePolicyResourceName, tenantgrouplifecyclepolicy.NewGroupLifecyclePolicyID(*existing.Id).ID())
+			}
+		}
+	}
+
+	properties := stable.GroupLifecyclePolicy{
+		AlternateNotificationEmails: nullable.Value(strings.Join(tf.ExpandStringSlice(d.Get("alternate_notification_emails").([]interface{})), ";")),
+		GroupLifetimeInDays:         nullable.Value(int64(d.Get("lifetime_in_days").(int))),
+		ManagedGroupTypes:           nullable.Value(d.Get("managed_group_types").(string)),
+	}
+
+	createResp, err := client.CreateGroupLifecyclePolicy(ctx, properties, tenantgrouplifecyclepolicy.DefaultCreateGroupLifecyclePolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating group lifecycle policy")
+	}
+
+	policy := createResp.Model
+	if policy == nil || policy.Id == nil {
+		return tf.ErrorDiagF(errors.New("returned model or ID was nil"), "Creating group lifecycle policy")
+	}
+
+	id := tenantgrouplifecyclepolicy.NewGroupLifecyclePolicyID(*policy.Id)
+	d.SetId(id.ID())
+
+	// Wait for the policy to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetGroupLifecyclePolicy(ctx, id, tenantgrouplifecyclepolicy.DefaultGetGroupLifecyclePolicyOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+
+	id, err := tenantgrouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := stable.GroupLifecyclePolicy{
+		AlternateNotificationEmails: nullable.Value(strings.Join(tf.ExpandStringSlice(d.Get("alternate_notification_emails").([]interface{})), ";")),
+		GroupLifetimeInDays:         nullable.Value(int64(d.Get("lifetime_in_days").(int))),
+		ManagedGroupTypes:           nullable.Value(d.Get("managed_group_types").(string)),
+	}
+
+	if _, err = client.UpdateGroupLifecyclePolicy(ctx, *id, properties, tenantgrouplifecyclepolicy.DefaultUpdateGroupLifecyclePolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+
+	id, err := tenantgrouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, *id, tenantgrouplifecyclepolicy.DefaultGetGroupLifecyclePolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	alternateNotificationEmails := make([]string, 0)
+	for _, email := range strings.Split(policy.AlternateNotificationEmails.GetOrZero(), ";") {
+		if email = strings.TrimSpace(email); email != "" {
+			alternateNotificationEmails = append(alternateNotificationEmails, email)
+		}
+	}
+
+	tf.Set(d, "alternate_notification_emails", alternateNotificationEmails)
+	tf.Set(d, "lifetime_in_days", int(policy.GroupLifetimeInDays.GetOrZero()))
+	tf.Set(d, "managed_group_types", policy.ManagedGroupTypes.GetOrZero())
+
+	return nil
+}
+
+func groupLifecyclePolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.TenantGroupLifecyclePolicyClient
+
+	id, err := tenantgrouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err = client.DeleteGroupLifecyclePolicy(ctx, *id, tenantgrouplifecyclepolicy.DefaultDeleteGroupLifecyclePolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	// Wait for policy object to be deleted
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetGroupLifecyclePolicy(ctx, *id, tenantgrouplifecyclepolicy.DefaultGetGroupLifecyclePolicyOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/tenantgrouplifecyclepolicy"
+)
+
+type GroupLifecyclePolicyResource struct{}
+
+func TestAccGroupLifecyclePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lifetime_in_days").HasValue("180"),
+				check.That(data.ResourceName).Key("managed_group_types").HasValue("All"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lifetime_in_days").HasValue("365"),
+				check.That(data.ResourceName).Key("managed_group_types").HasValue("Selected"),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupLifecyclePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.TenantGroupLifecyclePolicyClient
+
+	id, err := tenantgrouplifecyclepolicy.ParseGroupLifecyclePolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, *id, tenantgrouplifecyclepolicy.DefaultGetGroupLifecyclePolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupLifecyclePolicyResource) basic(_ acceptance.TestData) string {
+	return `
+resource "azuread_group_lifecycle_policy" "test" {
+  lifetime_in_days    = 180
+  managed_group_types = "All"
+}
+`
+}
+
+func (GroupLifecyclePolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  lifetime_in_days    = 365
+  managed_group_types = "Selected"
+
+  alternate_notification_emails = [
+    "acctest-%[1]d-a@${data.azuread_domains.test.domains.0.domain_name}",
+    "acctest-%[1]d-b@${data.azuread_domains.test.domains.0.domain_name}",
+  ]
+}
+`, data.RandomInteger)
+}
+
+func (r GroupLifecyclePolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_lifecycle_policy" "import" {
+  lifetime_in_days    = azuread_group_lifecycle_policy.test.lifetime_in_days
+  managed_group_types = azuread_group_lifecycle_policy.test.managed_group_types
+}
+`, r.basic(data))
+}
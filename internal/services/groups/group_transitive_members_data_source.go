@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	transitivememberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/transitivemember"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func groupTransitiveMembersDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: groupTransitiveMembersDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group for which to expand transitive members",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"types": {
+				Description: "The types of member objects to return. When omitted, members of all types are returned",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(possibleValuesForMemberObjectType, false),
+				},
+			},
+
+			"object_ids": {
+				Description: "The object IDs of all transitive members matching the specified types",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"device_object_ids": {
+				Description: "The object IDs of transitive members that are devices",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"group_object_ids": {
+				Description: "The object IDs of transitive members that are groups",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"service_principal_object_ids": {
+				Description: "The object IDs of transitive members that are service principals",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"user_object_ids": {
+				Description: "The object IDs of transitive members that are users",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func groupTransitiveMembersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupTransitiveMemberClientBeta
+
+	groupId := beta.NewGroupID(d.Get("group_object_id").(string))
+
+	types := tf.ExpandStringSlice(d.Get("types").(*pluginsdk.Set).List())
+	if len(types) == 0 {
+		types = possibleValuesForMemberObjectType
+	}
+
+	options := transitivememberBeta.ListTransitiveMembersOperationOptions{
+		Select: &[]string{"id"},
+	}
+
+	resp, err := client.ListTransitiveMembers(ctx, groupId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "No group found with object ID: %q", groupId.GroupId)
+		}
+		return tf.ErrorDiagF(err, "Could not retrieve transitive group members for %s", groupId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not retrieve transitive group members for %s", groupId)
+	}
+
+	objectIds := make([]string, 0)
+	membersByType := map[string][]string{
+		MemberObjectTypeDevice:           make([]string, 0),
+		MemberObjectTypeGroup:            make([]string, 0),
+		MemberObjectTypeServicePrincipal: make([]string, 0),
+		MemberObjectTypeUser:             make([]string, 0),
+	}
+
+	for _, object := range *resp.Model {
+		directoryObject := object.DirectoryObject()
+		if directoryObject.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned member with nil object ID"), "Bad API Response")
+		}
+
+		objectType := memberObjectTypeFromODataType(pointer.From(directoryObject.ODataType))
+		if !slices.Contains(types, objectType) {
+			continue
+		}
+
+		objectIds = append(objectIds, *directoryObject.Id)
+		membersByType[objectType] = append(membersByType[objectType], *directoryObject.Id)
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(groupId.GroupId + "/" + strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for transitive member object IDs")
+	}
+
+	d.SetId(fmt.Sprintf("transitiveMembers#%s#%s", base64.URLEncoding.EncodeToString(h.Sum(nil)), groupId.GroupId))
+
+	tf.Set(d, "object_ids", objectIds)
+	tf.Set(d, "device_object_ids", membersByType[MemberObjectTypeDevice])
+	tf.Set(d, "group_object_ids", membersByType[MemberObjectTypeGroup])
+	tf.Set(d, "service_principal_object_ids", membersByType[MemberObjectTypeServicePrincipal])
+	tf.Set(d, "user_object_ids", membersByType[MemberObjectTypeUser])
+
+	return nil
+}
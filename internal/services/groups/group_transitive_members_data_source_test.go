@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type GroupTransitiveMembersDataSource struct{}
+
+func TestAccGroupTransitiveMembersDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_transitive_members", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: GroupTransitiveMembersDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("4"),
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("service_principal_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("user_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("device_object_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccGroupTransitiveMembersDataSource_types(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_transitive_members", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: GroupTransitiveMembersDataSource{}.types(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("service_principal_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("user_object_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (GroupTransitiveMembersDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_group_transitive_members" "test" {
+  group_object_id = azuread_group.test.object_id
+}
+`, GroupResource{}.withTransitiveMembers(data))
+}
+
+func (GroupTransitiveMembersDataSource) types(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_group_transitive_members" "test" {
+  group_object_id = azuread_group.test.object_id
+  types           = ["ServicePrincipal", "User"]
+}
+`, GroupResource{}.withTransitiveMembers(data))
+}
@@ -7,12 +7,18 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
 	memberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/member"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/sdk/groupsettingtemplate"
 )
 
 func groupDefaultMailNickname() string {
@@ -96,3 +102,67 @@ func groupGetMember(ctx context.Context, client *memberBeta.MemberClient, id bet
 
 	return nil, nil
 }
+
+// memberObjectTypeFromODataType returns the shortened object type (e.g. `ServicePrincipal`) for the provided OData type
+// (e.g. `#microsoft.graph.servicePrincipal`). Unrecognised types are returned with the namespace prefix removed.
+func memberObjectTypeFromODataType(in string) string {
+	objectType := strings.TrimPrefix(in, "#microsoft.graph.")
+	for _, v := range possibleValuesForMemberObjectType {
+		if strings.EqualFold(v, objectType) {
+			return v
+		}
+	}
+	return objectType
+}
+
+func directorySettingTemplateFind(ctx context.Context, client *groupsettingtemplate.GroupSettingTemplateClient, templateId, displayName string) (*stable.GroupSettingTemplate, error) {
+	resp, err := client.ListGroupSettingTemplates(ctx, groupsettingtemplate.DefaultListGroupSettingTemplatesOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing directory setting templates: %v", err)
+	}
+
+	if resp.Model != nil {
+		for _, template := range *resp.Model {
+			if (templateId != "" && strings.EqualFold(pointer.From(template.Id), templateId)) ||
+				(displayName != "" && strings.EqualFold(template.DisplayName.GetOrZero(), displayName)) {
+				return &template, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// directorySettingValidateValue checks that the provided value can be parsed as the type declared in the setting template
+func directorySettingValidateValue(settingType, value string) error {
+	switch settingType {
+	case DirectorySettingValueTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a boolean value, got %q", value)
+		}
+	case DirectorySettingValueTypeGuid:
+		if value == "" {
+			return nil
+		}
+		if _, err := uuid.ParseUUID(value); err != nil {
+			return fmt.Errorf("expected a GUID value, got %q", value)
+		}
+	case DirectorySettingValueTypeInt32:
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+			return fmt.Errorf("expected a 32-bit integer value, got %q", value)
+		}
+	}
+
+	return nil
+}
+
+func expandDirectorySettingValues(in map[string]string) *[]stable.SettingValue {
+	result := make([]stable.SettingValue, 0, len(in))
+	for name, value := range in {
+		result = append(result, stable.SettingValue{
+			Name:  nullable.Value(name),
+			Value: nullable.Value(value),
+		})
+	}
+	return &result
+}
@@ -18,6 +18,7 @@ import (
 	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/query"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -33,11 +34,12 @@ func groupsDataSource() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"object_ids": {
-				Description:  "The object IDs of the groups",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				Description:   "The object IDs of the groups",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "display_names", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -45,11 +47,12 @@ func groupsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_names": {
-				Description:  "The display names of the groups",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				Description:   "The display names of the groups",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "filter", "object_ids", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -57,12 +60,31 @@ func groupsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_name_prefix": {
-				Description:  "Common display name prefix of the groups",
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:   "Common display name prefix of the groups",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "filter", "object_ids", "return_all", "search"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"filter": {
+				Description:   "An OData filter expression used to find groups. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"search": {
+				Description:   "An OData search expression used to find groups, e.g. `displayName:marketing`. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 
 			"ignore_missing": {
@@ -70,15 +92,15 @@ func groupsDataSource() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
 				Description:   "Retrieve all groups with no filter",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				AtLeastOneOf:  []string{"display_name_prefix", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "display_names", "filter", "ignore_missing", "object_ids", "search"},
 			},
 
 			"mail_enabled": {
@@ -108,6 +130,8 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 	var ignoreMissing = d.Get("ignore_missing").(bool)
 	var returnAll = d.Get("return_all").(bool)
 	var displayNamePrefix = d.Get("display_name_prefix").(string)
+	var filterExpression = d.Get("filter").(string)
+	var searchExpression = d.Get("search").(string)
 
 	var displayNames []interface{}
 	if v, ok := d.GetOk("display_names"); ok {
@@ -123,22 +147,32 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 		filter = append(filter, fmt.Sprintf("securityEnabled eq %t", v.(bool)))
 	}
 
-	if returnAll {
-		options := groupBeta.ListGroupsOperationOptions{
-			Filter: pointer.To(strings.Join(filter, " and ")),
+	if returnAll || filterExpression != "" || searchExpression != "" {
+		// Stream the results one page at a time, selecting only the fields we need, to keep memory usage low for large tenants
+		options := query.Options{
+			Advanced: filterExpression != "" || searchExpression != "",
+			Filter:   query.JoinFilters(append(filter, filterExpression)...),
+			Search:   query.NormalizeSearch(searchExpression),
+			Select:   []string{"displayName", "id"},
+			Top:      query.DefaultPageSize,
 		}
-		resp, err := client.ListGroups(ctx, options)
-		if err != nil {
+		if _, err := query.ListPages(ctx, client.Client, "/groups", options, func(page []beta.Group) error {
+			groups = append(groups, page...)
+			return nil
+		}); err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve groups")
 		}
-		if resp.Model == nil {
-			return tf.ErrorDiagF(errors.New("model was nil"), "Bad API Response")
-		}
-		if len(*resp.Model) == 0 {
-			return tf.ErrorDiagPathF(err, "return_all", "No groups found")
-		}
 
-		groups = append(groups, *resp.Model...)
+		if len(groups) == 0 {
+			switch {
+			case filterExpression != "":
+				return tf.ErrorDiagPathF(nil, "filter", "No groups found matching filter: %q", filterExpression)
+			case searchExpression != "":
+				return tf.ErrorDiagPathF(nil, "search", "No groups found matching search: %q", searchExpression)
+			default:
+				return tf.ErrorDiagPathF(nil, "return_all", "No groups found")
+			}
+		}
 	} else if displayNamePrefix != "" {
 		options := groupBeta.ListGroupsOperationOptions{
 			Filter: pointer.To(strings.Join(append(filter, fmt.Sprintf("startsWith(displayName, '%s')", odata.EscapeSingleQuote(displayNamePrefix))), " and ")),
@@ -204,7 +238,7 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	if !returnAll && !ignoreMissing && displayNamePrefix == "" && len(groups) != expectedCount {
+	if !returnAll && !ignoreMissing && displayNamePrefix == "" && filterExpression == "" && searchExpression == "" && len(groups) != expectedCount {
 		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(groups)), "Unexpected number of groups returned")
 	}
 
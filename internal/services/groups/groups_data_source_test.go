@@ -74,6 +74,42 @@ func TestAccGroupsDataSource_byDisplayNamePrefix(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_byFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+	r := GroupsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.byFilter(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccGroupsDataSource_bySearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+	r := GroupsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.bySearch(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").HasValue("3"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			),
+		},
+	})
+}
+
 func TestAccGroupsDataSource_byObjectIds(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
 	r := GroupsDataSource{}
@@ -318,6 +354,30 @@ data "azuread_groups" "test" {
 `, r.template(data))
 }
 
+func (r GroupsDataSource) byFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  filter     = "startsWith(displayName, 'acctestGroup') and endsWith(displayName, '-%[2]d')"
+  depends_on = [azuread_group.testA, azuread_group.testB, azuread_group.testC]
+
+  mail_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GroupsDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  search     = "displayName:%[2]d"
+  depends_on = [azuread_group.testA, azuread_group.testB, azuread_group.testC]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r GroupsDataSource) byObjectIds(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type GroupLifecyclePolicyAssociationId struct {
+	ObjectSubResourceId
+	GroupLifecyclePolicyId string
+	GroupId                string
+}
+
+func NewGroupLifecyclePolicyAssociationID(groupLifecyclePolicyId, groupId string) GroupLifecyclePolicyAssociationId {
+	return GroupLifecyclePolicyAssociationId{
+		ObjectSubResourceId:    NewObjectSubResourceID(groupLifecyclePolicyId, "group", groupId),
+		GroupLifecyclePolicyId: groupLifecyclePolicyId,
+		GroupId:                groupId,
+	}
+}
+
+func GroupLifecyclePolicyAssociationID(idString string) (*GroupLifecyclePolicyAssociationId, error) {
+	id, err := ObjectSubResourceID(idString, "group")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Group Lifecycle Policy Association ID: %v", err)
+	}
+
+	return &GroupLifecyclePolicyAssociationId{
+		ObjectSubResourceId:    *id,
+		GroupLifecyclePolicyId: id.objectId,
+		GroupId:                id.subId,
+	}, nil
+}
@@ -27,15 +27,19 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_group":  groupDataSource(),
-		"azuread_groups": groupsDataSource(),
+		"azuread_group":                    groupDataSource(),
+		"azuread_group_transitive_members": groupTransitiveMembersDataSource(),
+		"azuread_groups":                   groupsDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_group":        groupResource(),
-		"azuread_group_member": groupMemberResource(),
+		"azuread_directory_setting":                  directorySettingResource(),
+		"azuread_group":                              groupResource(),
+		"azuread_group_lifecycle_policy":             groupLifecyclePolicyResource(),
+		"azuread_group_lifecycle_policy_association": groupLifecyclePolicyAssociationResource(),
+		"azuread_group_member":                       groupMemberResource(),
 	}
 }
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// GroupSettingClient provides access to the tenant-wide /groupSettings endpoints, which are not currently exposed by
+// go-azure-sdk.
+type GroupSettingClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupSettingClientWithBaseURI(sdkApi sdkEnv.Api) (*GroupSettingClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "groupsetting", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupSettingClient: %+v", err)
+	}
+
+	return &GroupSettingClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GroupSettingId{}
+
+// GroupSettingId is a struct representing the Resource ID for a Group Setting
+type GroupSettingId struct {
+	GroupSettingId string
+}
+
+// NewGroupSettingID returns a new GroupSettingId struct
+func NewGroupSettingID(groupSettingId string) GroupSettingId {
+	return GroupSettingId{
+		GroupSettingId: groupSettingId,
+	}
+}
+
+// ParseGroupSettingID parses 'input' into a GroupSettingId
+func ParseGroupSettingID(input string) (*GroupSettingId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupSettingId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupSettingId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseGroupSettingIDInsensitively parses 'input' case-insensitively into a GroupSettingId
+// note: this method should only be used for API response data and not user input
+func ParseGroupSettingIDInsensitively(input string) (*GroupSettingId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupSettingId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupSettingId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GroupSettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupSettingId, ok = input.Parsed["groupSettingId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupSettingId", input)
+	}
+
+	return nil
+}
+
+// ValidateGroupSettingID checks that 'input' can be parsed as a Group Setting ID
+func ValidateGroupSettingID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupSettingID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Group Setting ID
+func (id GroupSettingId) ID() string {
+	fmtString := "/groupSettings/%s"
+	return fmt.Sprintf(fmtString, id.GroupSettingId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Group Setting ID
+func (id GroupSettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groupSettings", "groupSettings", "groupSettings"),
+		resourceids.UserSpecifiedSegment("groupSettingId", "groupSettingId"),
+	}
+}
+
+// String returns a human-readable description of this Group Setting ID
+func (id GroupSettingId) String() string {
+	components := []string{
+		fmt.Sprintf("Group Setting: %q", id.GroupSettingId),
+	}
+	return fmt.Sprintf("Group Setting (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type DeleteGroupSettingOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type DeleteGroupSettingOperationOptions struct {
+	IfMatch   *string
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultDeleteGroupSettingOperationOptions() DeleteGroupSettingOperationOptions {
+	return DeleteGroupSettingOperationOptions{}
+}
+
+func (o DeleteGroupSettingOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o DeleteGroupSettingOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o DeleteGroupSettingOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// DeleteGroupSetting - Delete a tenant-level group setting.
+func (c GroupSettingClient) DeleteGroupSetting(ctx context.Context, id GroupSettingId, options DeleteGroupSettingOperationOptions) (result DeleteGroupSettingOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: options,
+		Path:          id.ID(),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
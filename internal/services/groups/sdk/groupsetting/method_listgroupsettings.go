@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListGroupSettingsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupSetting
+}
+
+type ListGroupSettingsCompleteResult struct {
+	LatestHttpResponse *http.Response
+	Items              []stable.GroupSetting
+}
+
+type ListGroupSettingsOperationOptions struct {
+	Filter    *string
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+	Select    *[]string
+	Skip      *int64
+	Top       *int64
+}
+
+func DefaultListGroupSettingsOperationOptions() ListGroupSettingsOperationOptions {
+	return ListGroupSettingsOperationOptions{}
+}
+
+func (o ListGroupSettingsOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o ListGroupSettingsOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Filter != nil {
+		out.Filter = *o.Filter
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	if o.Select != nil {
+		out.Select = *o.Select
+	}
+	if o.Skip != nil {
+		out.Skip = int(*o.Skip)
+	}
+	if o.Top != nil {
+		out.Top = int(*o.Top)
+	}
+	return &out
+}
+
+func (o ListGroupSettingsOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+type ListGroupSettingsCustomPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *ListGroupSettingsCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// ListGroupSettings - List settings. Retrieve a list of tenant-level group settings objects.
+func (c GroupSettingClient) ListGroupSettings(ctx context.Context, options ListGroupSettingsOperationOptions) (result ListGroupSettingsOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListGroupSettingsCustomPager{},
+		Path:          "/groupSettings",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]stable.GroupSetting `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+// ListGroupSettingsComplete retrieves all the results into a single object
+func (c GroupSettingClient) ListGroupSettingsComplete(ctx context.Context, options ListGroupSettingsOperationOptions) (result ListGroupSettingsCompleteResult, err error) {
+	resp, err := c.ListGroupSettings(ctx, options)
+	if err != nil {
+		result.LatestHttpResponse = resp.HttpResponse
+		err = fmt.Errorf("loading results: %+v", err)
+		return
+	}
+
+	items := make([]stable.GroupSetting, 0)
+	if resp.Model != nil {
+		items = append(items, *resp.Model...)
+	}
+
+	result = ListGroupSettingsCompleteResult{
+		LatestHttpResponse: resp.HttpResponse,
+		Items:              items,
+	}
+	return
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsettingtemplate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// GroupSettingTemplateClient provides access to the /groupSettingTemplates endpoints, which are not currently exposed
+// by go-azure-sdk.
+type GroupSettingTemplateClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupSettingTemplateClientWithBaseURI(sdkApi sdkEnv.Api) (*GroupSettingTemplateClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "groupsettingtemplate", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupSettingTemplateClient: %+v", err)
+	}
+
+	return &GroupSettingTemplateClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsettingtemplate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GroupSettingTemplateId{}
+
+// GroupSettingTemplateId is a struct representing the Resource ID for a Group Setting Template
+type GroupSettingTemplateId struct {
+	GroupSettingTemplateId string
+}
+
+// NewGroupSettingTemplateID returns a new GroupSettingTemplateId struct
+func NewGroupSettingTemplateID(groupSettingTemplateId string) GroupSettingTemplateId {
+	return GroupSettingTemplateId{
+		GroupSettingTemplateId: groupSettingTemplateId,
+	}
+}
+
+// ParseGroupSettingTemplateID parses 'input' into a GroupSettingTemplateId
+func ParseGroupSettingTemplateID(input string) (*GroupSettingTemplateId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupSettingTemplateId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupSettingTemplateId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseGroupSettingTemplateIDInsensitively parses 'input' case-insensitively into a GroupSettingTemplateId
+// note: this method should only be used for API response data and not user input
+func ParseGroupSettingTemplateIDInsensitively(input string) (*GroupSettingTemplateId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupSettingTemplateId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupSettingTemplateId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GroupSettingTemplateId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupSettingTemplateId, ok = input.Parsed["groupSettingTemplateId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupSettingTemplateId", input)
+	}
+
+	return nil
+}
+
+// ValidateGroupSettingTemplateID checks that 'input' can be parsed as a Group Setting Template ID
+func ValidateGroupSettingTemplateID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupSettingTemplateID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Group Setting Template ID
+func (id GroupSettingTemplateId) ID() string {
+	fmtString := "/groupSettingTemplates/%s"
+	return fmt.Sprintf(fmtString, id.GroupSettingTemplateId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Group Setting Template ID
+func (id GroupSettingTemplateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groupSettingTemplates", "groupSettingTemplates", "groupSettingTemplates"),
+		resourceids.UserSpecifiedSegment("groupSettingTemplateId", "groupSettingTemplateId"),
+	}
+}
+
+// String returns a human-readable description of this Group Setting Template ID
+func (id GroupSettingTemplateId) String() string {
+	components := []string{
+		fmt.Sprintf("Group Setting Template: %q", id.GroupSettingTemplateId),
+	}
+	return fmt.Sprintf("Group Setting Template (%s)", strings.Join(components, "\n"))
+}
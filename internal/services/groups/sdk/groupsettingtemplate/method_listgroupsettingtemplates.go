@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsettingtemplate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListGroupSettingTemplatesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupSettingTemplate
+}
+
+type ListGroupSettingTemplatesCompleteResult struct {
+	LatestHttpResponse *http.Response
+	Items              []stable.GroupSettingTemplate
+}
+
+type ListGroupSettingTemplatesOperationOptions struct {
+	Filter    *string
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+	Select    *[]string
+	Skip      *int64
+	Top       *int64
+}
+
+func DefaultListGroupSettingTemplatesOperationOptions() ListGroupSettingTemplatesOperationOptions {
+	return ListGroupSettingTemplatesOperationOptions{}
+}
+
+func (o ListGroupSettingTemplatesOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o ListGroupSettingTemplatesOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Filter != nil {
+		out.Filter = *o.Filter
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	if o.Select != nil {
+		out.Select = *o.Select
+	}
+	if o.Skip != nil {
+		out.Skip = int(*o.Skip)
+	}
+	if o.Top != nil {
+		out.Top = int(*o.Top)
+	}
+	return &out
+}
+
+func (o ListGroupSettingTemplatesOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+type ListGroupSettingTemplatesCustomPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *ListGroupSettingTemplatesCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// ListGroupSettingTemplates - List groupSettingTemplates. Retrieve a list of group setting templates that are available for creating group settings.
+func (c GroupSettingTemplateClient) ListGroupSettingTemplates(ctx context.Context, options ListGroupSettingTemplatesOperationOptions) (result ListGroupSettingTemplatesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListGroupSettingTemplatesCustomPager{},
+		Path:          "/groupSettingTemplates",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]stable.GroupSettingTemplate `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+// ListGroupSettingTemplatesComplete retrieves all the results into a single object
+func (c GroupSettingTemplateClient) ListGroupSettingTemplatesComplete(ctx context.Context, options ListGroupSettingTemplatesOperationOptions) (result ListGroupSettingTemplatesCompleteResult, err error) {
+	resp, err := c.ListGroupSettingTemplates(ctx, options)
+	if err != nil {
+		result.LatestHttpResponse = resp.HttpResponse
+		err = fmt.Errorf("loading results: %+v", err)
+		return
+	}
+
+	items := make([]stable.GroupSettingTemplate, 0)
+	if resp.Model != nil {
+		items = append(items, *resp.Model...)
+	}
+
+	result = ListGroupSettingTemplatesCompleteResult{
+		LatestHttpResponse: resp.HttpResponse,
+		Items:              items,
+	}
+	return
+}
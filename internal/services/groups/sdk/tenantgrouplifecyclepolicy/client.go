@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// TenantGroupLifecyclePolicyClient provides access to the tenant-wide /groupLifecyclePolicies endpoints. go-azure-sdk
+// only exposes lifecycle policies beneath a group, so policies cannot otherwise be managed independently of any group.
+type TenantGroupLifecyclePolicyClient struct {
+	Client *msgraph.Client
+}
+
+func NewTenantGroupLifecyclePolicyClientWithBaseURI(sdkApi sdkEnv.Api) (*TenantGroupLifecyclePolicyClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "grouplifecyclepolicy", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TenantGroupLifecyclePolicyClient: %+v", err)
+	}
+
+	return &TenantGroupLifecyclePolicyClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GroupLifecyclePolicyId{}
+
+// GroupLifecyclePolicyId is a struct representing the Resource ID for a Group Lifecycle Policy
+type GroupLifecyclePolicyId struct {
+	GroupLifecyclePolicyId string
+}
+
+// NewGroupLifecyclePolicyID returns a new GroupLifecyclePolicyId struct
+func NewGroupLifecyclePolicyID(groupLifecyclePolicyId string) GroupLifecyclePolicyId {
+	return GroupLifecyclePolicyId{
+		GroupLifecyclePolicyId: groupLifecyclePolicyId,
+	}
+}
+
+// ParseGroupLifecyclePolicyID parses 'input' into a GroupLifecyclePolicyId
+func ParseGroupLifecyclePolicyID(input string) (*GroupLifecyclePolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupLifecyclePolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupLifecyclePolicyId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseGroupLifecyclePolicyIDInsensitively parses 'input' case-insensitively into a GroupLifecyclePolicyId
+// note: this method should only be used for API response data and not user input
+func ParseGroupLifecyclePolicyIDInsensitively(input string) (*GroupLifecyclePolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupLifecyclePolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupLifecyclePolicyId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GroupLifecyclePolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupLifecyclePolicyId, ok = input.Parsed["groupLifecyclePolicyId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupLifecyclePolicyId", input)
+	}
+
+	return nil
+}
+
+// ValidateGroupLifecyclePolicyID checks that 'input' can be parsed as a Group Lifecycle Policy ID
+func ValidateGroupLifecyclePolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupLifecyclePolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Group Lifecycle Policy ID
+func (id GroupLifecyclePolicyId) ID() string {
+	fmtString := "/groupLifecyclePolicies/%s"
+	return fmt.Sprintf(fmtString, id.GroupLifecyclePolicyId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Group Lifecycle Policy ID
+func (id GroupLifecyclePolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groupLifecyclePolicies", "groupLifecyclePolicies", "groupLifecyclePolicies"),
+		resourceids.UserSpecifiedSegment("groupLifecyclePolicyId", "groupLifecyclePolicyId"),
+	}
+}
+
+// String returns a human-readable description of this Group Lifecycle Policy ID
+func (id GroupLifecyclePolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Group Lifecycle Policy: %q", id.GroupLifecyclePolicyId),
+	}
+	return fmt.Sprintf("Group Lifecycle Policy (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type AddGroupLifecyclePolicyGroupOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *grouplifecyclepolicy.AddGroupLifecyclePolicyGroupResult
+}
+
+type AddGroupLifecyclePolicyGroupOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultAddGroupLifecyclePolicyGroupOperationOptions() AddGroupLifecyclePolicyGroupOperationOptions {
+	return AddGroupLifecyclePolicyGroupOperationOptions{}
+}
+
+func (o AddGroupLifecyclePolicyGroupOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o AddGroupLifecyclePolicyGroupOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o AddGroupLifecyclePolicyGroupOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// AddGroupLifecyclePolicyGroup - Invoke action addGroup. Adds specific groups to a lifecycle policy. This action limits
+// the group lifecycle policy to a set of groups only if the managedGroupTypes property of groupLifecyclePolicy is set
+// to Selected.
+func (c TenantGroupLifecyclePolicyClient) AddGroupLifecyclePolicyGroup(ctx context.Context, id GroupLifecyclePolicyId, input grouplifecyclepolicy.AddGroupLifecyclePolicyGroupRequest, options AddGroupLifecyclePolicyGroupOperationOptions) (result AddGroupLifecyclePolicyGroupOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: options,
+		Path:          fmt.Sprintf("%s/addGroup", id.ID()),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model grouplifecyclepolicy.AddGroupLifecyclePolicyGroupResult
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
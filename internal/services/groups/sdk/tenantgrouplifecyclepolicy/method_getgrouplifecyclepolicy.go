@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type GetGroupLifecyclePolicyOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupLifecyclePolicy
+}
+
+type GetGroupLifecyclePolicyOperationOptions struct {
+	Expand    *odata.Expand
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+	Select    *[]string
+}
+
+func DefaultGetGroupLifecyclePolicyOperationOptions() GetGroupLifecyclePolicyOperationOptions {
+	return GetGroupLifecyclePolicyOperationOptions{}
+}
+
+func (o GetGroupLifecyclePolicyOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o GetGroupLifecyclePolicyOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Expand != nil {
+		out.Expand = *o.Expand
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	if o.Select != nil {
+		out.Select = *o.Select
+	}
+	return &out
+}
+
+func (o GetGroupLifecyclePolicyOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// GetGroupLifecyclePolicy - Get groupLifecyclePolicy. Retrieve the properties and relationships of a groupLifecyclePolicies object.
+func (c TenantGroupLifecyclePolicyClient) GetGroupLifecyclePolicy(ctx context.Context, id GroupLifecyclePolicyId, options GetGroupLifecyclePolicyOperationOptions) (result GetGroupLifecyclePolicyOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Path:          id.ID(),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupLifecyclePolicy
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
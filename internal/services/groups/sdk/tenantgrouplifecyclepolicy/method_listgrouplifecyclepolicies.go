@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListGroupLifecyclePoliciesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupLifecyclePolicy
+}
+
+type ListGroupLifecyclePoliciesCompleteResult struct {
+	LatestHttpResponse *http.Response
+	Items              []stable.GroupLifecyclePolicy
+}
+
+type ListGroupLifecyclePoliciesOperationOptions struct {
+	Count     *bool
+	Expand    *odata.Expand
+	Filter    *string
+	Metadata  *odata.Metadata
+	OrderBy   *odata.OrderBy
+	RetryFunc client.RequestRetryFunc
+	Search    *string
+	Select    *[]string
+	Skip      *int64
+	Top       *int64
+}
+
+func DefaultListGroupLifecyclePoliciesOperationOptions() ListGroupLifecyclePoliciesOperationOptions {
+	return ListGroupLifecyclePoliciesOperationOptions{}
+}
+
+func (o ListGroupLifecyclePoliciesOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o ListGroupLifecyclePoliciesOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Count != nil {
+		out.Count = *o.Count
+	}
+	if o.Expand != nil {
+		out.Expand = *o.Expand
+	}
+	if o.Filter != nil {
+		out.Filter = *o.Filter
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	if o.OrderBy != nil {
+		out.OrderBy = *o.OrderBy
+	}
+	if o.Search != nil {
+		out.Search = *o.Search
+	}
+	if o.Select != nil {
+		out.Select = *o.Select
+	}
+	if o.Skip != nil {
+		out.Skip = int(*o.Skip)
+	}
+	if o.Top != nil {
+		out.Top = int(*o.Top)
+	}
+	return &out
+}
+
+func (o ListGroupLifecyclePoliciesOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+type ListGroupLifecyclePoliciesCustomPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *ListGroupLifecyclePoliciesCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// ListGroupLifecyclePolicies - List groupLifecyclePolicies. Retrieves a list of groupLifecyclePolicy objects.
+func (c TenantGroupLifecyclePolicyClient) ListGroupLifecyclePolicies(ctx context.Context, options ListGroupLifecyclePoliciesOperationOptions) (result ListGroupLifecyclePoliciesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListGroupLifecyclePoliciesCustomPager{},
+		Path:          "/groupLifecyclePolicies",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]stable.GroupLifecyclePolicy `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+// ListGroupLifecyclePoliciesComplete retrieves all the results into a single object
+func (c TenantGroupLifecyclePolicyClient) ListGroupLifecyclePoliciesComplete(ctx context.Context, options ListGroupLifecyclePoliciesOperationOptions) (result ListGroupLifecyclePoliciesCompleteResult, err error) {
+	resp, err := c.ListGroupLifecyclePolicies(ctx, options)
+	if err != nil {
+		result.LatestHttpResponse = resp.HttpResponse
+		err = fmt.Errorf("loading results: %+v", err)
+		return
+	}
+
+	items := make([]stable.GroupLifecyclePolicy, 0)
+	if resp.Model != nil {
+		items = append(items, *resp.Model...)
+	}
+
+	result = ListGroupLifecyclePoliciesCompleteResult{
+		LatestHttpResponse: resp.HttpResponse,
+		Items:              items,
+	}
+	return
+}
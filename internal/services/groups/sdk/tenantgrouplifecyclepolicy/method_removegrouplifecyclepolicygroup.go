@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tenantgrouplifecyclepolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type RemoveGroupLifecyclePolicyGroupOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *grouplifecyclepolicy.RemoveGroupLifecyclePolicyGroupResult
+}
+
+type RemoveGroupLifecyclePolicyGroupOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultRemoveGroupLifecyclePolicyGroupOperationOptions() RemoveGroupLifecyclePolicyGroupOperationOptions {
+	return RemoveGroupLifecyclePolicyGroupOperationOptions{}
+}
+
+func (o RemoveGroupLifecyclePolicyGroupOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o RemoveGroupLifecyclePolicyGroupOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o RemoveGroupLifecyclePolicyGroupOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// RemoveGroupLifecyclePolicyGroup - Invoke action removeGroup. Removes a group from a lifecycle policy.
+func (c TenantGroupLifecyclePolicyClient) RemoveGroupLifecyclePolicyGroup(ctx context.Context, id GroupLifecyclePolicyId, input grouplifecyclepolicy.RemoveGroupLifecyclePolicyGroupRequest, options RemoveGroupLifecyclePolicyGroupOperationOptions) (result RemoveGroupLifecyclePolicyGroupOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: options,
+		Path:          fmt.Sprintf("%s/removeGroup", id.ID()),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model grouplifecyclepolicy.RemoveGroupLifecyclePolicyGroupResult
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
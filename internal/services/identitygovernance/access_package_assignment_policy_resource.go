@@ -13,15 +13,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageassignmentpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementassignmentpolicy"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/validate"
 )
 
 const accessPackageAssignmentPolicyResourceName = "azuread_access_package_assignment_policy"
@@ -271,6 +275,67 @@ func accessPackageAssignmentPolicyResource() *pluginsdk.Resource {
 				},
 			},
 
+			"automatic_request_settings": {
+				Description: "Settings for automatically assigning the access package to users who match a membership rule",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"membership_rule": {
+							Description:      "The rule which determines the users to whom the access package is automatically assigned, e.g. `(user.department -eq \"Sales\")`",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.AutoAssignmentMembershipRule,
+						},
+
+						"membership_rule_description": {
+							Description:  "A description of the membership rule",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"remove_access_when_target_leaves": {
+							Description: "Whether the assignment is removed when a user no longer matches the membership rule",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"grace_period_before_access_removal": {
+							Description:  "How long a user retains access after they no longer match the membership rule, as an ISO8601 duration (e.g. P7D)",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"custom_extension_stage_setting": {
+				Description: "Custom workflow extensions which are called at stages of the assignment lifecycle",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"stage": {
+							Description:  "The stage of the assignment lifecycle at which the custom workflow extension is called",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(beta.PossibleValuesForAccessPackageCustomExtensionStage(), false),
+						},
+
+						"custom_extension_id": {
+							Description:  "The ID of the custom workflow extension to call",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
 			"question": {
 				Description:      "One or more questions to the requestor",
 				Type:             pluginsdk.TypeList,
@@ -366,11 +431,91 @@ func assignmentPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.Resource
 		}
 	}
 
+	if automaticRequestSettings := diff.Get("automatic_request_settings").([]interface{}); len(automaticRequestSettings) > 0 {
+		if diff.Get("approval_settings.0.approval_required").(bool) {
+			return fmt.Errorf("`approval_required` cannot be enabled for policies with `automatic_request_settings`")
+		}
+		if requestors := diff.Get("requestor_settings.0.requestor").([]interface{}); len(requestors) > 0 {
+			return fmt.Errorf("`requestor` cannot be specified for policies with `automatic_request_settings`, users are assigned according to the membership rule")
+		}
+		if questions := diff.Get("question").([]interface{}); len(questions) > 0 {
+			return fmt.Errorf("`question` cannot be specified for policies with `automatic_request_settings`, since there are no requestors to answer them")
+		}
+		if diff.Get("assignment_review_settings.0.enabled").(bool) {
+			return fmt.Errorf("`assignment_review_settings` cannot be enabled for policies with `automatic_request_settings`")
+		}
+	}
+
+	// Requestors which target specific objects must specify them, and requestors in connected organizations can only be
+	// targeted with the matching scope type
+	scopeType := diff.Get("requestor_settings.0.scope_type").(string)
+	for i, raw := range diff.Get("requestor_settings.0.requestor").([]interface{}) {
+		requestor, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		subjectType := formatODataType(requestor["subject_type"].(string))
+		switch subjectType {
+		case "ConnectedOrganizationMembers", "GroupMembers", "SingleUser":
+			if requestor["object_id"].(string) == "" && diff.NewValueKnown(fmt.Sprintf("requestor_settings.0.requestor.%d.object_id", i)) {
+				return fmt.Errorf("`object_id` must be specified for requestors with a `subject_type` of %q", requestor["subject_type"].(string))
+			}
+		}
+
+		if scopeType == "" {
+			continue
+		}
+		if subjectType == "ConnectedOrganizationMembers" && scopeType != RequestorScopeTypeSpecificConnectedOrganizationSubjects {
+			return fmt.Errorf("requestors with a `subject_type` of %q can only be specified when `scope_type` is %q", requestor["subject_type"].(string), RequestorScopeTypeSpecificConnectedOrganizationSubjects)
+		}
+		if subjectType != "ConnectedOrganizationMembers" && scopeType == RequestorScopeTypeSpecificConnectedOrganizationSubjects {
+			return fmt.Errorf("only requestors with a `subject_type` of \"connectedOrganizationMembers\" can be specified when `scope_type` is %q", RequestorScopeTypeSpecificConnectedOrganizationSubjects)
+		}
+	}
+
+	// Policies cannot be converted between automatic and request-based assignment
+	if diff.Id() != "" && diff.HasChange("automatic_request_settings") {
+		oldSettings, newSettings := diff.GetChange("automatic_request_settings")
+		if len(oldSettings.([]interface{})) != len(newSettings.([]interface{})) {
+			if err := diff.ForceNew("automatic_request_settings"); err != nil {
+				return err
+			}
+		}
+	}
+
+	stages := make(map[string]bool)
+	for _, raw := range diff.Get("custom_extension_stage_setting").([]interface{}) {
+		setting := raw.(map[string]interface{})
+		stage := setting["stage"].(string)
+		if stages[stage] {
+			return fmt.Errorf("only one `custom_extension_stage_setting` can be specified for the %q stage", stage)
+		}
+		stages[stage] = true
+	}
+
 	return nil
 }
 
 func accessPackageAssignmentPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyClient
+	stableClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyStableClient
+
+	// Automatic assignment is only supported by the v1.0 API, so automatic assignment policies are created with that API
+	if automaticRequestSettings := d.Get("automatic_request_settings").([]interface{}); len(automaticRequestSettings) > 0 {
+		resp, err := stableClient.CreateEntitlementManagementAssignmentPolicy(ctx, buildAutomaticAssignmentPolicyResourceData(d), entitlementmanagementassignmentpolicy.DefaultCreateEntitlementManagementAssignmentPolicyOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Creating access package assignment policy %q", d.Get("display_name").(string))
+		}
+
+		if resp.Model == nil || resp.Model.Id == nil {
+			return tf.ErrorDiagF(errors.New("model or ID was nil"), "Creating access package assignment policy")
+		}
+
+		d.SetId(*resp.Model.Id)
+
+		return accessPackageAssignmentPolicyResourceRead(ctx, d, meta)
+	}
 
 	properties, err := buildAssignmentPolicyResourceData(ctx, d, meta)
 	if err != nil {
@@ -394,17 +539,27 @@ func accessPackageAssignmentPolicyResourceCreate(ctx context.Context, d *plugins
 
 func accessPackageAssignmentPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyClient
+	stableClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyStableClient
 
 	id := beta.NewIdentityGovernanceEntitlementManagementAccessPackageAssignmentPolicyID(d.Id())
 
+	tf.LockByName(accessPackageAssignmentPolicyResourceName, id.AccessPackageAssignmentPolicyId)
+	defer tf.UnlockByName(accessPackageAssignmentPolicyResourceName, id.AccessPackageAssignmentPolicyId)
+
+	if automaticRequestSettings := d.Get("automatic_request_settings").([]interface{}); len(automaticRequestSettings) > 0 {
+		stableId := stable.NewIdentityGovernanceEntitlementManagementAssignmentPolicyID(id.AccessPackageAssignmentPolicyId)
+		if _, err := stableClient.SetEntitlementManagementAssignmentPolicy(ctx, stableId, buildAutomaticAssignmentPolicyResourceData(d), entitlementmanagementassignmentpolicy.DefaultSetEntitlementManagementAssignmentPolicyOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Updating %s", stableId)
+		}
+
+		return accessPackageAssignmentPolicyResourceRead(ctx, d, meta)
+	}
+
 	properties, err := buildAssignmentPolicyResourceData(ctx, d, meta)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Building resource data from supplied parameters")
 	}
 
-	tf.LockByName(accessPackageAssignmentPolicyResourceName, id.AccessPackageAssignmentPolicyId)
-	defer tf.UnlockByName(accessPackageAssignmentPolicyResourceName, id.AccessPackageAssignmentPolicyId)
-
 	if _, err = client.SetEntitlementManagementAccessPackageAssignmentPolicy(ctx, id, *properties, entitlementmanagementaccesspackageassignmentpolicy.DefaultSetEntitlementManagementAccessPackageAssignmentPolicyOperationOptions()); err != nil {
 		return tf.ErrorDiagF(err, "Updating %s", id)
 	}
@@ -414,10 +569,17 @@ func accessPackageAssignmentPolicyResourceUpdate(ctx context.Context, d *plugins
 
 func accessPackageAssignmentPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyClient
+	stableClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyStableClient
 
 	id := beta.NewIdentityGovernanceEntitlementManagementAccessPackageAssignmentPolicyID(d.Id())
 
-	resp, err := client.GetEntitlementManagementAccessPackageAssignmentPolicy(ctx, id, entitlementmanagementaccesspackageassignmentpolicy.DefaultGetEntitlementManagementAccessPackageAssignmentPolicyOperationOptions())
+	options := entitlementmanagementaccesspackageassignmentpolicy.GetEntitlementManagementAccessPackageAssignmentPolicyOperationOptions{
+		Expand: &odata.Expand{
+			Relationship: "customExtensionStageSettings($expand=customExtension)",
+		},
+	}
+
+	resp, err := client.GetEntitlementManagementAccessPackageAssignmentPolicy(ctx, id, options)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", id)
@@ -433,9 +595,18 @@ func accessPackageAssignmentPolicyResourceRead(ctx context.Context, d *pluginsdk
 		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
 	}
 
+	// Automatic assignment settings are only available from the v1.0 API
+	stableId := stable.NewIdentityGovernanceEntitlementManagementAssignmentPolicyID(id.AccessPackageAssignmentPolicyId)
+	stableResp, err := stableClient.GetEntitlementManagementAssignmentPolicy(ctx, stableId, entitlementmanagementassignmentpolicy.DefaultGetEntitlementManagementAssignmentPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", stableId)
+	}
+
 	tf.Set(d, "access_package_id", accessPackageAssignmentPolicy.AccessPackageId.GetOrZero())
 	tf.Set(d, "approval_settings", flattenApprovalSettings(accessPackageAssignmentPolicy.RequestApprovalSettings))
 	tf.Set(d, "assignment_review_settings", flattenAssignmentReviewSettings(accessPackageAssignmentPolicy.AccessReviewSettings))
+	tf.Set(d, "automatic_request_settings", flattenAutomaticRequestSettings(stableResp.Model))
+	tf.Set(d, "custom_extension_stage_setting", flattenCustomExtensionStageSettings(accessPackageAssignmentPolicy.CustomExtensionStageSettings))
 	tf.Set(d, "description", accessPackageAssignmentPolicy.Description.GetOrZero())
 	tf.Set(d, "display_name", accessPackageAssignmentPolicy.DisplayName.GetOrZero())
 	tf.Set(d, "duration_in_days", int(accessPackageAssignmentPolicy.DurationInDays.GetOrZero()))
@@ -493,6 +664,8 @@ func buildAssignmentPolicyResourceData(ctx context.Context, d *pluginsdk.Resourc
 		DurationInDays:     nullable.Value(int64(d.Get("duration_in_days").(int))),
 		ExpirationDateTime: nullable.NoZero(d.Get("expiration_date").(string)),
 		Questions:          expandAccessPackageQuestions(d.Get("question").([]interface{})),
+
+		CustomExtensionStageSettings: expandCustomExtensionStageSettings(d.Get("custom_extension_stage_setting").([]interface{})),
 	}
 
 	requestApprovalSettings, err := expandApprovalSettings(d.Get("approval_settings").([]interface{}))
@@ -515,3 +688,61 @@ func buildAssignmentPolicyResourceData(ctx context.Context, d *pluginsdk.Resourc
 
 	return &properties, nil
 }
+
+// buildAutomaticAssignmentPolicyResourceData builds a policy which automatically assigns the access package to users
+// matching a membership rule. Automatic assignment is only supported by the v1.0 API, which models the policy differently
+// from the beta API, so these policies are built separately. Automatic assignment policies have no requestors, so
+// requestor settings, questions and access reviews do not apply.
+func buildAutomaticAssignmentPolicyResourceData(d *pluginsdk.ResourceData) stable.AccessPackageAssignmentPolicy {
+	policy := stable.AccessPackageAssignmentPolicy{
+		AccessPackage: &stable.AccessPackage{
+			Id: pointer.To(d.Get("access_package_id").(string)),
+		},
+		Description: nullable.NoZero(d.Get("description").(string)),
+		DisplayName: nullable.NoZero(d.Get("display_name").(string)),
+		Expiration: &stable.ExpirationPattern{
+			Type: pointer.To(stable.ExpirationPatternType_NoExpiration),
+		},
+		RequestApprovalSettings: &stable.AccessPackageAssignmentApprovalSettings{
+			IsApprovalRequiredForAdd:    nullable.Value(false),
+			IsApprovalRequiredForUpdate: nullable.Value(d.Get("approval_settings.0.approval_required_for_extension").(bool)),
+		},
+		RequestorSettings: &stable.AccessPackageAssignmentRequestorSettings{
+			EnableTargetsToSelfAddAccess:    nullable.Value(false),
+			EnableTargetsToSelfRemoveAccess: nullable.Value(false),
+			EnableTargetsToSelfUpdateAccess: nullable.Value(d.Get("extension_enabled").(bool)),
+		},
+	}
+
+	if v := d.Get("duration_in_days").(int); v > 0 {
+		policy.Expiration = &stable.ExpirationPattern{
+			Duration: nullable.Value(fmt.Sprintf("P%dD", v)),
+			Type:     pointer.To(stable.ExpirationPatternType_AfterDuration),
+		}
+	} else if v := d.Get("expiration_date").(string); v != "" {
+		policy.Expiration = &stable.ExpirationPattern{
+			EndDateTime: nullable.Value(v),
+			Type:        pointer.To(stable.ExpirationPatternType_AfterDateTime),
+		}
+	}
+
+	customExtensionStageSettings := make([]stable.CustomExtensionStageSetting, 0)
+	for _, raw := range d.Get("custom_extension_stage_setting").([]interface{}) {
+		v := raw.(map[string]interface{})
+
+		var customExtension stable.CustomCalloutExtension = stable.BaseCustomCalloutExtensionImpl{
+			Id:                     pointer.To(v["custom_extension_id"].(string)),
+			OmitDiscriminatedValue: true,
+		}
+
+		customExtensionStageSettings = append(customExtensionStageSettings, stable.CustomExtensionStageSetting{
+			CustomExtension: &customExtension,
+			Stage:           pointer.To(stable.AccessPackageCustomExtensionStage(v["stage"].(string))),
+		})
+	}
+	policy.CustomExtensionStageSettings = &customExtensionStageSettings
+
+	expandAutomaticRequestSettings(d.Get("automatic_request_settings").([]interface{}), &policy)
+
+	return policy
+}
@@ -123,6 +123,70 @@ func TestAccAccessPackageAssignmentPolicy_removeQuestion(t *testing.T) {
 	})
 }
 
+func TestAccAccessPackageAssignmentPolicy_automaticRequest(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.automaticRequest(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("automatic_request_settings.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.automaticRequestUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("automatic_request_settings.0.grace_period_before_access_removal").HasValue("P7D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignmentPolicy_customExtensionStageSetting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customExtensionStageSetting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_extension_stage_setting.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.simple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_extension_stage_setting.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignmentPolicy_connectedOrganization(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectedOrganization(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requestor_settings.0.requestor.0.object_id").IsUuid(),
+			),
+		},
+		data.ImportStep("access_package_id"),
+	})
+}
+
 func (AccessPackageAssignmentPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.IdentityGovernance.AccessPackageAssignmentPolicyClient
 	id := beta.NewIdentityGovernanceEntitlementManagementAccessPackageAssignmentPolicyID(state.ID)
@@ -414,3 +478,143 @@ resource "azuread_access_package_assignment_policy" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (AccessPackageAssignmentPolicyResource) automaticRequest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-catalog-%[1]d"
+  description  = "Test catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "acctest-access-package-%[1]d"
+  description  = "Test access package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "acctest-auto-assignment-%[1]d"
+  description       = "Test auto-assignment policy %[1]d"
+  access_package_id = azuread_access_package.test.id
+
+  automatic_request_settings {
+    membership_rule = "(user.department -eq \"acctest-%[1]d\")"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (AccessPackageAssignmentPolicyResource) automaticRequestUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-catalog-%[1]d"
+  description  = "Test catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "acctest-access-package-%[1]d"
+  description  = "Test access package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "acctest-auto-assignment-%[1]d"
+  description       = "Test auto-assignment policy %[1]d"
+  access_package_id = azuread_access_package.test.id
+
+  automatic_request_settings {
+    membership_rule                    = "(user.department -eq \"acctest-%[1]d\") -and (user.accountEnabled -eq true)"
+    membership_rule_description        = "Enabled users in the test department"
+    remove_access_when_target_leaves   = true
+    grace_period_before_access_removal = "P7D"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (AccessPackageAssignmentPolicyResource) customExtensionStageSetting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test_catalog" {
+  display_name = "test-catalog-%[1]d"
+  description  = "Test Catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "access-package-%[1]d"
+  description  = "Test Access Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test_catalog.id
+}
+
+resource "azuread_access_package_custom_workflow_extension" "test" {
+  catalog_id   = azuread_access_package_catalog.test_catalog.id
+  display_name = "acctest-extension-%[1]d"
+  description  = "Test custom workflow extension %[1]d"
+
+  logic_app {
+    subscription_id     = "%[2]s"
+    resource_group_name = "acctestRG-%[1]d"
+    workflow_name       = "acctest-workflow-%[1]d"
+    url                 = "https://acctest-%[1]d.example.com/workflows/trigger"
+  }
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "access-package-assignment-policy-%[1]d"
+  description       = "Test Access Package Assignnment Policy %[1]d"
+  access_package_id = azuread_access_package.test.id
+
+  custom_extension_stage_setting {
+    stage               = "assignmentRequestGranted"
+    custom_extension_id = azuread_access_package_custom_workflow_extension.test.extension_id
+  }
+}
+`, data.RandomInteger, data.RandomID)
+}
+
+func (AccessPackageAssignmentPolicyResource) connectedOrganization(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-connected-organization-%[1]d"
+
+  identity_source {
+    domain_name = "acctest-%[1]d.example.com"
+  }
+}
+
+resource "azuread_access_package_catalog" "test_catalog" {
+  display_name = "test-catalog-%[1]d"
+  description  = "Test Catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "access-package-%[1]d"
+  description  = "Test Access Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test_catalog.id
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "access-package-assignment-policy-%[1]d"
+  description       = "Test Access Package Assignnment Policy %[1]d"
+  duration_in_days  = 90
+  access_package_id = azuread_access_package.test.id
+
+  requestor_settings {
+    scope_type        = "SpecificConnectedOrganizationSubjects"
+    requests_accepted = true
+
+    requestor {
+      object_id    = azuread_connected_organization.test.id
+      subject_type = "connectedOrganizationMembers"
+    }
+  }
+}
+`, data.RandomInteger)
+}
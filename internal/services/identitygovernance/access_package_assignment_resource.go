@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageassignmentpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementassignment"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accesspackageassignmentrequest"
+)
+
+const accessPackageAssignmentResourceName = "azuread_access_package_assignment"
+
+func accessPackageAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessPackageAssignmentResourceCreate,
+		ReadContext:   accessPackageAssignmentResourceRead,
+		DeleteContext: accessPackageAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(15 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"assignment_policy_id": {
+				Description:  "The ID of the access package assignment policy under which the access package is assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"target_object_id": {
+				Description:  "The object ID of the user to whom the access package is assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"justification": {
+				Description:  "The justification for the assignment",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"start_date": {
+				Description:      "The date that the assignment starts, formatted as an RFC3339 date string in UTC (e.g. 2018-01-01T01:02:03Z)",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"expiration_date": {
+				Description:      "The date that the assignment expires, formatted as an RFC3339 date string in UTC (e.g. 2018-01-01T01:02:03Z)",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"duration"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"duration": {
+				Description:   "The duration of the assignment, formatted as an ISO8601 duration string (e.g. P30D for 30 days)",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"expiration_date"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"access_package_id": {
+				Description: "The ID of the access package which is assigned",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"state": {
+				Description: "The state of the assignment",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "More information about the state of the assignment",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"expired_date": {
+				Description: "The date that the assignment expired, if it has expired",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessPackageAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+	requestClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentRequestClient
+	policyClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentPolicyClient
+
+	policyId := beta.NewIdentityGovernanceEntitlementManagementAccessPackageAssignmentPolicyID(d.Get("assignment_policy_id").(string))
+	targetObjectId := d.Get("target_object_id").(string)
+
+	lockName := fmt.Sprintf("%s/%s", policyId.AccessPackageAssignmentPolicyId, targetObjectId)
+	tf.LockByName(accessPackageAssignmentResourceName, lockName)
+	defer tf.UnlockByName(accessPackageAssignmentResourceName, lockName)
+
+	// Adopt an existing assignment, so that assignments made outside Terraform, or left behind by an earlier failed
+	// apply, do not prevent the resource from being created
+	existing, err := accessPackageAssignmentFind(ctx, client, policyId.AccessPackageAssignmentPolicyId, targetObjectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing access package assignment")
+	}
+	if existing != nil && existing.Id != nil {
+		log.Printf("[DEBUG] Adopting existing access package assignment %q for target %q", *existing.Id, targetObjectId)
+		d.SetId(*existing.Id)
+		return accessPackageAssignmentResourceRead(ctx, d, meta)
+	}
+
+	policyResp, err := policyClient.GetEntitlementManagementAccessPackageAssignmentPolicy(ctx, policyId, entitlementmanagementaccesspackageassignmentpolicy.DefaultGetEntitlementManagementAccessPackageAssignmentPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "assignment_policy_id", "Retrieving %s", policyId)
+	}
+	if policyResp.Model == nil || policyResp.Model.AccessPackageId.GetOrZero() == "" {
+		return tf.ErrorDiagPathF(errors.New("model or access package ID was nil"), "assignment_policy_id", "Retrieving %s", policyId)
+	}
+
+	properties := accesspackageassignmentrequest.AccessPackageAssignmentRequest{
+		Assignment: &accesspackageassignmentrequest.AccessPackageAssignment{
+			AccessPackageId:    pointer.To(policyResp.Model.AccessPackageId.GetOrZero()),
+			AssignmentPolicyId: pointer.To(policyId.AccessPackageAssignmentPolicyId),
+			TargetId:           pointer.To(targetObjectId),
+		},
+		Justification: nullable.NoZero(d.Get("justification").(string)),
+		RequestType:   pointer.To(stable.AccessPackageRequestType_AdminAdd),
+		Schedule:      expandAccessPackageAssignmentSchedule(d),
+	}
+
+	resp, err := requestClient.CreateAccessPackageAssignmentRequest(ctx, properties, accesspackageassignmentrequest.DefaultCreateAccessPackageAssignmentRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Requesting access package assignment")
+	}
+	if resp.Model == nil || resp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("model or ID was nil"), "Requesting access package assignment")
+	}
+
+	requestId := stable.NewIdentityGovernanceEntitlementManagementAssignmentRequestID(*resp.Model.Id)
+
+	request, err := accessPackageAssignmentRequestWait(ctx, requestClient, requestId, []string{
+		string(stable.AccessPackageRequestState_Delivered),
+		string(stable.AccessPackageRequestState_Scheduled),
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for delivery of access package assignment")
+	}
+	if request.Assignment == nil || request.Assignment.Id == nil {
+		return tf.ErrorDiagF(errors.New("assignment or ID was nil"), "Retrieving access package assignment for %s", requestId)
+	}
+
+	id := stable.NewIdentityGovernanceEntitlementManagementAssignmentID(*request.Assignment.Id)
+	d.SetId(id.AccessPackageAssignmentId)
+
+	// Wait for the assignment to be consistently readable
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEntitlementManagementAssignment(ctx, id, entitlementmanagementassignment.DefaultGetEntitlementManagementAssignmentOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return accessPackageAssignmentResourceRead(ctx, d, meta)
+}
+
+func accessPackageAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+
+	id := stable.NewIdentityGovernanceEntitlementManagementAssignmentID(d.Id())
+
+	options := entitlementmanagementassignment.GetEntitlementManagementAssignmentOperationOptions{
+		Expand: &odata.Expand{Relationship: "*"},
+	}
+
+	resp, err := client.GetEntitlementManagementAssignment(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	assignment := resp.Model
+	if assignment == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	accessPackageId := ""
+	if assignment.AccessPackage != nil {
+		accessPackageId = pointer.From(assignment.AccessPackage.Id)
+	}
+
+	assignmentPolicyId := ""
+	if assignment.AssignmentPolicy != nil {
+		assignmentPolicyId = pointer.From(assignment.AssignmentPolicy.Id)
+	}
+
+	targetObjectId := ""
+	if assignment.Target != nil {
+		targetObjectId = assignment.Target.ObjectId.GetOrZero()
+	}
+
+	startDate := ""
+	expirationDate := ""
+	if schedule := assignment.Schedule; schedule != nil {
+		startDate = schedule.StartDateTime.GetOrZero()
+		if schedule.Expiration != nil {
+			expirationDate = schedule.Expiration.EndDateTime.GetOrZero()
+		}
+	}
+
+	tf.Set(d, "access_package_id", accessPackageId)
+	tf.Set(d, "assignment_policy_id", assignmentPolicyId)
+	tf.Set(d, "expiration_date", expirationDate)
+	tf.Set(d, "expired_date", assignment.ExpiredDateTime.GetOrZero())
+	tf.Set(d, "start_date", startDate)
+	tf.Set(d, "state", string(pointer.From(assignment.State)))
+	tf.Set(d, "status", assignment.Status.GetOrZero())
+	tf.Set(d, "target_object_id", targetObjectId)
+
+	return nil
+}
+
+func accessPackageAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+	requestClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentRequestClient
+
+	id := stable.NewIdentityGovernanceEntitlementManagementAssignmentID(d.Id())
+
+	resp, err := client.GetEntitlementManagementAssignment(ctx, id, entitlementmanagementassignment.DefaultGetEntitlementManagementAssignmentOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	// Expired assignments cannot be removed
+	if resp.Model != nil && pointer.From(resp.Model.State) == stable.AccessPackageAssignmentState_Expired {
+		return nil
+	}
+
+	properties := accesspackageassignmentrequest.AccessPackageAssignmentRequest{
+		Assignment: &accesspackageassignmentrequest.AccessPackageAssignment{
+			Id: pointer.To(id.AccessPackageAssignmentId),
+		},
+		RequestType: pointer.To(stable.AccessPackageRequestType_AdminRemove),
+	}
+
+	requestResp, err := requestClient.CreateAccessPackageAssignmentRequest(ctx, properties, accesspackageassignmentrequest.DefaultCreateAccessPackageAssignmentRequestOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Requesting removal of %s", id)
+	}
+	if requestResp.Model == nil || requestResp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("model or ID was nil"), "Requesting removal of %s", id)
+	}
+
+	requestId := stable.NewIdentityGovernanceEntitlementManagementAssignmentRequestID(*requestResp.Model.Id)
+	if _, err = accessPackageAssignmentRequestWait(ctx, requestClient, requestId, []string{string(stable.AccessPackageRequestState_Delivered)}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	// Wait for the assignment to be removed, after which it is either no longer found or has expired
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEntitlementManagementAssignment(ctx, id, entitlementmanagementassignment.DefaultGetEntitlementManagementAssignmentOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(resp.Model != nil && pointer.From(resp.Model.State) != stable.AccessPackageAssignmentState_Expired), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+// accessPackageAssignmentFind returns an active assignment for the specified target under the specified policy, if
+// one exists
+func accessPackageAssignmentFind(ctx context.Context, client *entitlementmanagementassignment.EntitlementManagementAssignmentClient, assignmentPolicyId, targetObjectId string) (*stable.AccessPackageAssignment, error) {
+	options := entitlementmanagementassignment.ListEntitlementManagementAssignmentsOperationOptions{
+		Expand: &odata.Expand{Relationship: "*"},
+		Filter: pointer.To(fmt.Sprintf("target/objectId eq '%s'", odata.EscapeSingleQuote(targetObjectId))),
+	}
+
+	resp, err := client.ListEntitlementManagementAssignments(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing access package assignments for target %q: %v", targetObjectId, err)
+	}
+
+	for _, assignment := range pointer.From(resp.Model) {
+		if assignment.AssignmentPolicy == nil || !strings.EqualFold(pointer.From(assignment.AssignmentPolicy.Id), assignmentPolicyId) {
+			continue
+		}
+
+		switch pointer.From(assignment.State) {
+		case stable.AccessPackageAssignmentState_Delivered, stable.AccessPackageAssignmentState_Delivering, stable.AccessPackageAssignmentState_PartiallyDelivered:
+			return &assignment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// accessPackageAssignmentRequestWait waits for an assignment request to reach one of the target states, returning an
+// error if the request fails, is denied or is canceled
+func accessPackageAssignmentRequestWait(ctx context.Context, client *accesspackageassignmentrequest.AccessPackageAssignmentRequestClient, id stable.IdentityGovernanceEntitlementManagementAssignmentRequestId, target []string) (*accesspackageassignmentrequest.AccessPackageAssignmentRequest, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, errors.New("context has no deadline")
+	}
+
+	options := accesspackageassignmentrequest.GetAccessPackageAssignmentRequestOperationOptions{
+		Expand: &odata.Expand{Relationship: "assignment"},
+	}
+
+	result, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending: []string{
+			string(stable.AccessPackageRequestState_Delivering),
+			string(stable.AccessPackageRequestState_PartiallyDelivered),
+			string(stable.AccessPackageRequestState_PendingApproval),
+			string(stable.AccessPackageRequestState_Scheduled),
+			string(stable.AccessPackageRequestState_Submitted),
+		},
+		Target:     target,
+		Timeout:    time.Until(deadline),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAccessPackageAssignmentRequest(ctx, id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil, string(stable.AccessPackageRequestState_Submitted), nil
+				}
+				return nil, "Error", fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			request := resp.Model
+			if request == nil || request.State == nil {
+				return nil, "Error", fmt.Errorf("retrieving %s: model or state was nil", id)
+			}
+
+			switch state := *request.State; state {
+			case stable.AccessPackageRequestState_Canceled, stable.AccessPackageRequestState_DeliveryFailed, stable.AccessPackageRequestState_Denied:
+				return nil, string(state), fmt.Errorf("request was %s: %s", state, request.Status.GetOrZero())
+			}
+
+			return request, string(*request.State), nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, ok := result.(*accesspackageassignmentrequest.AccessPackageAssignmentRequest)
+	if !ok || request == nil {
+		return nil, fmt.Errorf("unexpected result waiting for %s", id)
+	}
+
+	return request, nil
+}
+
+func expandAccessPackageAssignmentSchedule(d *pluginsdk.ResourceData) *stable.EntitlementManagementSchedule {
+	startDate := d.Get("start_date").(string)
+	expirationDate := d.Get("expiration_date").(string)
+	duration := d.Get("duration").(string)
+
+	if startDate == "" && expirationDate == "" && duration == "" {
+		return nil
+	}
+
+	result := stable.EntitlementManagementSchedule{
+		StartDateTime: nullable.NoZero(startDate),
+		Expiration: &stable.ExpirationPattern{
+			Type: pointer.To(stable.ExpirationPatternType_NoExpiration),
+		},
+	}
+
+	if expirationDate != "" {
+		result.Expiration = &stable.ExpirationPattern{
+			EndDateTime: nullable.Value(expirationDate),
+			Type:        pointer.To(stable.ExpirationPatternType_AfterDateTime),
+		}
+	} else if duration != "" {
+		result.Expiration = &stable.ExpirationPattern{
+			Duration: nullable.Value(duration),
+			Type:     pointer.To(stable.ExpirationPatternType_AfterDuration),
+		}
+	}
+
+	return &result
+}
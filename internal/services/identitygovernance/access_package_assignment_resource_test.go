@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementassignment"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AccessPackageAssignmentResource struct{}
+
+func TestAccAccessPackageAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_package_id").IsUuid(),
+				check.That(data.ResourceName).Key("state").HasValue("delivered"),
+			),
+		},
+		data.ImportStep("justification"),
+	})
+}
+
+func TestAccAccessPackageAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("delivered"),
+				check.That(data.ResourceName).Key("expiration_date").Exists(),
+			),
+		},
+		data.ImportStep("justification"),
+	})
+}
+
+func TestAccAccessPackageAssignment_duration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.duration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("delivered"),
+			),
+		},
+		data.ImportStep("duration", "justification"),
+	})
+}
+
+func (AccessPackageAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessPackageAssignmentClient
+	id := stable.NewIdentityGovernanceEntitlementManagementAssignmentID(state.ID)
+
+	resp, err := client.GetEntitlementManagementAssignment(ctx, id, entitlementmanagementassignment.DefaultGetEntitlementManagementAssignmentOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil && pointer.From(resp.Model.State) != stable.AccessPackageAssignmentState_Expired), nil
+}
+
+func (AccessPackageAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-catalog-%[1]d"
+  description  = "Test catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "acctest-access-package-%[1]d"
+  description  = "Test access package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "acctest-assignment-policy-%[1]d"
+  description       = "Test assignment policy %[1]d"
+  access_package_id = azuread_access_package.test.id
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r AccessPackageAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  target_object_id     = azuread_user.test.object_id
+}
+`, r.template(data))
+}
+
+func (r AccessPackageAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  target_object_id     = azuread_user.test.object_id
+  justification        = "Needed for acceptance testing"
+  expiration_date      = "%[2]s"
+}
+`, r.template(data), time.Now().UTC().AddDate(0, 0, 7).Format(time.RFC3339))
+}
+
+func (r AccessPackageAssignmentResource) duration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  target_object_id     = azuread_user.test.object_id
+  justification        = "Needed for acceptance testing"
+  duration             = "P7D"
+}
+`, r.template(data))
+}
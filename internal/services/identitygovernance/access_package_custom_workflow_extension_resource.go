@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementcatalogcustomworkflowextension"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/validate"
+)
+
+const accessPackageCustomWorkflowExtensionResourceName = "azuread_access_package_custom_workflow_extension"
+
+func accessPackageCustomWorkflowExtensionResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessPackageCustomWorkflowExtensionResourceCreate,
+		ReadContext:   accessPackageCustomWorkflowExtensionResourceRead,
+		UpdateContext: accessPackageCustomWorkflowExtensionResourceUpdate,
+		DeleteContext: accessPackageCustomWorkflowExtensionResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validate.AccessPackageCustomWorkflowExtensionID),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"catalog_id": {
+				Description:  "The ID of the access package catalog in which to create the custom workflow extension",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"display_name": {
+				Description:  "The display name of the custom workflow extension",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description:  "The description of the custom workflow extension",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"logic_app": {
+				Description: "The Logic App workflow which is triggered by the custom workflow extension",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subscription_id": {
+							Description:  "The ID of the Azure subscription containing the Logic App",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"resource_group_name": {
+							Description:  "The name of the resource group containing the Logic App",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"workflow_name": {
+							Description:  "The name of the Logic App workflow",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"url": {
+							Description:  "The trigger URL of the Logic App workflow, required when authenticated as a service principal",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsHttpsUrl,
+						},
+					},
+				},
+			},
+
+			"callback_timeout": {
+				Description:  "How long to wait for the Logic App to call back before the request continues, as an ISO8601 duration (e.g. PT1H). When not specified, the request continues without waiting",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"extension_id": {
+				Description: "The ID of the custom workflow extension",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessPackageCustomWorkflowExtensionResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageCustomWorkflowExtensionClient
+
+	catalogId := stable.NewIdentityGovernanceEntitlementManagementCatalogID(d.Get("catalog_id").(string))
+
+	resp, err := client.CreateEntitlementManagementCatalogCustomWorkflowExtension(ctx, catalogId, expandAccessPackageCustomWorkflowExtension(d), entitlementmanagementcatalogcustomworkflowextension.DefaultCreateEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating custom workflow extension %q in %s", d.Get("display_name").(string), catalogId)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating custom workflow extension")
+	}
+
+	extensionId := resp.Model.CustomCalloutExtension().Id
+	if extensionId == nil {
+		return tf.ErrorDiagF(errors.New("ID was nil"), "Creating custom workflow extension")
+	}
+
+	id := parse.NewAccessPackageCustomWorkflowExtensionID(catalogId.AccessPackageCatalogId, *extensionId)
+	d.SetId(id.ID())
+
+	// Wait for the custom workflow extension to be consistently readable, so that it can be used in policies
+	resourceId := stable.NewIdentityGovernanceEntitlementManagementCatalogIdCustomWorkflowExtensionID(id.CatalogId, id.CustomWorkflowExtensionId)
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, entitlementmanagementcatalogcustomworkflowextension.DefaultGetEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", resourceId)
+	}
+
+	return accessPackageCustomWorkflowExtensionResourceRead(ctx, d, meta)
+}
+
+func accessPackageCustomWorkflowExtensionResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageCustomWorkflowExtensionClient
+
+	id, err := parse.AccessPackageCustomWorkflowExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	tf.LockByName(accessPackageCustomWorkflowExtensionResourceName, id.ID())
+	defer tf.UnlockByName(accessPackageCustomWorkflowExtensionResourceName, id.ID())
+
+	resourceId := stable.NewIdentityGovernanceEntitlementManagementCatalogIdCustomWorkflowExtensionID(id.CatalogId, id.CustomWorkflowExtensionId)
+
+	if _, err = client.UpdateEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, expandAccessPackageCustomWorkflowExtension(d), entitlementmanagementcatalogcustomworkflowextension.DefaultUpdateEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", resourceId)
+	}
+
+	return accessPackageCustomWorkflowExtensionResourceRead(ctx, d, meta)
+}
+
+func accessPackageCustomWorkflowExtensionResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageCustomWorkflowExtensionClient
+
+	id, err := parse.AccessPackageCustomWorkflowExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	resourceId := stable.NewIdentityGovernanceEntitlementManagementCatalogIdCustomWorkflowExtensionID(id.CatalogId, id.CustomWorkflowExtensionId)
+
+	resp, err := client.GetEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, entitlementmanagementcatalogcustomworkflowextension.DefaultGetEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", resourceId)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", resourceId)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", resourceId)
+	}
+
+	extension, ok := resp.Model.(stable.AccessPackageAssignmentRequestWorkflowExtension)
+	if !ok {
+		return tf.ErrorDiagF(fmt.Errorf("unexpected custom extension type %T", resp.Model), "Retrieving %s", resourceId)
+	}
+
+	callbackTimeout := ""
+	if extension.CallbackConfiguration != nil {
+		callbackTimeout = extension.CallbackConfiguration.CustomExtensionCallbackConfiguration().TimeoutDuration.GetOrZero()
+	}
+
+	logicApp := make([]map[string]interface{}, 0)
+	if endpoint, ok := extension.EndpointConfiguration.(stable.LogicAppTriggerEndpointConfiguration); ok {
+		logicApp = append(logicApp, map[string]interface{}{
+			"resource_group_name": endpoint.ResourceGroupName.GetOrZero(),
+			"subscription_id":     endpoint.SubscriptionId.GetOrZero(),
+			"url":                 endpoint.Url.GetOrZero(),
+			"workflow_name":       endpoint.LogicAppWorkflowName.GetOrZero(),
+		})
+	}
+
+	tf.Set(d, "callback_timeout", callbackTimeout)
+	tf.Set(d, "catalog_id", id.CatalogId)
+	tf.Set(d, "description", extension.Description.GetOrZero())
+	tf.Set(d, "display_name", extension.DisplayName.GetOrZero())
+	tf.Set(d, "extension_id", id.CustomWorkflowExtensionId)
+	tf.Set(d, "logic_app", logicApp)
+
+	return nil
+}
+
+func accessPackageCustomWorkflowExtensionResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageCustomWorkflowExtensionClient
+
+	id, err := parse.AccessPackageCustomWorkflowExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	resourceId := stable.NewIdentityGovernanceEntitlementManagementCatalogIdCustomWorkflowExtensionID(id.CatalogId, id.CustomWorkflowExtensionId)
+
+	if _, err = client.DeleteEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, entitlementmanagementcatalogcustomworkflowextension.DefaultDeleteEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", resourceId)
+	}
+
+	// Wait for object to be deleted
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, entitlementmanagementcatalogcustomworkflowextension.DefaultGetEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", resourceId)
+	}
+
+	return nil
+}
+
+func expandAccessPackageCustomWorkflowExtension(d *pluginsdk.ResourceData) stable.AccessPackageAssignmentRequestWorkflowExtension {
+	result := stable.AccessPackageAssignmentRequestWorkflowExtension{
+		AuthenticationConfiguration: stable.AzureAdPopTokenAuthentication{},
+		Description:                 nullable.Value(d.Get("description").(string)),
+		DisplayName:                 nullable.Value(d.Get("display_name").(string)),
+		EndpointConfiguration: stable.LogicAppTriggerEndpointConfiguration{
+			LogicAppWorkflowName: nullable.Value(d.Get("logic_app.0.workflow_name").(string)),
+			ResourceGroupName:    nullable.Value(d.Get("logic_app.0.resource_group_name").(string)),
+			SubscriptionId:       nullable.Value(d.Get("logic_app.0.subscription_id").(string)),
+			Url:                  nullable.NoZero(d.Get("logic_app.0.url").(string)),
+		},
+	}
+
+	if callbackTimeout := d.Get("callback_timeout").(string); callbackTimeout != "" {
+		result.CallbackConfiguration = stable.BaseCustomExtensionCallbackConfigurationImpl{
+			ODataType:       pointer.To("#microsoft.graph.customExtensionCallbackConfiguration"),
+			TimeoutDuration: nullable.Value(callbackTimeout),
+		}
+	}
+
+	return result
+}
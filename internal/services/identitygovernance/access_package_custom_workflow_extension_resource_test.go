@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementcatalogcustomworkflowextension"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+)
+
+type AccessPackageCustomWorkflowExtensionResource struct{}
+
+func TestAccAccessPackageCustomWorkflowExtension_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_custom_workflow_extension", "test")
+	r := AccessPackageCustomWorkflowExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageCustomWorkflowExtension_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_custom_workflow_extension", "test")
+	r := AccessPackageCustomWorkflowExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("callback_timeout").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AccessPackageCustomWorkflowExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessPackageCustomWorkflowExtensionClient
+
+	id, err := parse.AccessPackageCustomWorkflowExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := stable.NewIdentityGovernanceEntitlementManagementCatalogIdCustomWorkflowExtensionID(id.CatalogId, id.CustomWorkflowExtensionId)
+
+	resp, err := client.GetEntitlementManagementCatalogCustomWorkflowExtension(ctx, resourceId, entitlementmanagementcatalogcustomworkflowextension.DefaultGetEntitlementManagementCatalogCustomWorkflowExtensionOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", resourceId, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AccessPackageCustomWorkflowExtensionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-catalog-%[1]d"
+  description  = "Test catalog %[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r AccessPackageCustomWorkflowExtensionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_custom_workflow_extension" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctest-extension-%[2]d"
+
+  logic_app {
+    subscription_id     = "%[3]s"
+    resource_group_name = "acctestRG-%[2]d"
+    workflow_name       = "acctest-workflow-%[2]d"
+    url                 = "https://acctest-%[2]d.example.com/workflows/trigger"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomID)
+}
+
+func (r AccessPackageCustomWorkflowExtensionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_custom_workflow_extension" "test" {
+  catalog_id       = azuread_access_package_catalog.test.id
+  display_name     = "acctest-extension-%[2]d-updated"
+  description      = "Test custom workflow extension %[2]d"
+  callback_timeout = "PT1H"
+
+  logic_app {
+    subscription_id     = "%[3]s"
+    resource_group_name = "acctestRG-%[2]d"
+    workflow_name       = "acctest-workflow-%[2]d"
+    url                 = "https://acctest-%[2]d.example.com/workflows/trigger"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomID)
+}
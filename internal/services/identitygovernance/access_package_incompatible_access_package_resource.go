@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatibleaccesspackage"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/validate"
+)
+
+func accessPackageIncompatibleAccessPackageResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessPackageIncompatibleAccessPackageResourceCreate,
+		ReadContext:   accessPackageIncompatibleAccessPackageResourceRead,
+		DeleteContext: accessPackageIncompatibleAccessPackageResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validate.AccessPackageIncompatibleAccessPackageID),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"access_package_id": {
+				Description:  "The ID of the access package",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"incompatible_access_package_id": {
+				Description:  "The ID of the access package which is incompatible with the access package. Users who are assigned this access package cannot request the access package",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func accessPackageIncompatibleAccessPackageResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleAccessPackageClient
+
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(d.Get("access_package_id").(string))
+	incompatibleAccessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(d.Get("incompatible_access_package_id").(string))
+	resourceId := parse.NewAccessPackageIncompatibleAccessPackageID(accessPackageId.AccessPackageId, incompatibleAccessPackageId.AccessPackageId)
+
+	tf.LockByName(accessPackageResourceName, accessPackageId.AccessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, accessPackageId.AccessPackageId)
+
+	existing, err := accessPackageGetIncompatibleAccessPackage(ctx, client, resourceId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing incompatible access packages for %s", accessPackageId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_access_package_incompatible_access_package", resourceId.ID())
+	}
+
+	properties := stable.ReferenceCreate{
+		ODataId: pointer.To(client.Client.BaseUri + incompatibleAccessPackageId.ID()),
+	}
+
+	if _, err = client.AddEntitlementManagementAccessPackageIncompatibleRef(ctx, accessPackageId, properties, entitlementmanagementaccesspackageincompatibleaccesspackage.DefaultAddEntitlementManagementAccessPackageIncompatibleRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Adding incompatible access package %q to %s", incompatibleAccessPackageId.AccessPackageId, accessPackageId)
+	}
+
+	d.SetId(resourceId.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		incompatibleAccessPackage, err := accessPackageGetIncompatibleAccessPackage(ctx, client, resourceId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(incompatibleAccessPackage != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for incompatible access package %q to be added to %s", incompatibleAccessPackageId.AccessPackageId, accessPackageId)
+	}
+
+	return accessPackageIncompatibleAccessPackageResourceRead(ctx, d, meta)
+}
+
+func accessPackageIncompatibleAccessPackageResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleAccessPackageClient
+
+	resourceId, err := parse.AccessPackageIncompatibleAccessPackageID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	incompatibleAccessPackage, err := accessPackageGetIncompatibleAccessPackage(ctx, client, *resourceId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving incompatible access package %q for access package %q", resourceId.IncompatibleAccessPackageId, resourceId.AccessPackageId)
+	}
+	if incompatibleAccessPackage == nil {
+		log.Printf("[DEBUG] Incompatible access package %q for access package %q was not found - removing from state!", resourceId.IncompatibleAccessPackageId, resourceId.AccessPackageId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "access_package_id", resourceId.AccessPackageId)
+	tf.Set(d, "incompatible_access_package_id", resourceId.IncompatibleAccessPackageId)
+
+	return nil
+}
+
+func accessPackageIncompatibleAccessPackageResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleAccessPackageClient
+
+	resourceId, err := parse.AccessPackageIncompatibleAccessPackageID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	tf.LockByName(accessPackageResourceName, resourceId.AccessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, resourceId.AccessPackageId)
+
+	id := stable.NewIdentityGovernanceEntitlementManagementAccessPackageIdIncompatibleAccessPackageID(resourceId.AccessPackageId, resourceId.IncompatibleAccessPackageId)
+
+	if _, err = client.RemoveEntitlementManagementAccessPackageIncompatibleRef(ctx, id, entitlementmanagementaccesspackageincompatibleaccesspackage.DefaultRemoveEntitlementManagementAccessPackageIncompatibleRefOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Removing %s", id)
+	}
+
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		incompatibleAccessPackage, err := accessPackageGetIncompatibleAccessPackage(ctx, client, *resourceId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(incompatibleAccessPackage != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
+
+// accessPackageGetIncompatibleAccessPackage returns the incompatible access package referenced by the specified ID, or
+// nil if the access package is not marked as incompatible or the access package no longer exists.
+func accessPackageGetIncompatibleAccessPackage(ctx context.Context, client *entitlementmanagementaccesspackageincompatibleaccesspackage.EntitlementManagementAccessPackageIncompatibleAccessPackageClient, id parse.AccessPackageIncompatibleAccessPackageId) (*stable.AccessPackage, error) {
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(id.AccessPackageId)
+
+	resp, err := client.ListEntitlementManagementAccessPackageIncompatibleAccessPackages(ctx, accessPackageId, entitlementmanagementaccesspackageincompatibleaccesspackage.DefaultListEntitlementManagementAccessPackageIncompatibleAccessPackagesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing incompatible access packages for %s: %v", accessPackageId, err)
+	}
+
+	for _, accessPackage := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(accessPackage.Id), id.IncompatibleAccessPackageId) {
+			return &accessPackage, nil
+		}
+	}
+
+	return nil, nil
+}
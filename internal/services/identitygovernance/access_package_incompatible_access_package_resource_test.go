@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatibleaccesspackage"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+)
+
+type AccessPackageIncompatibleAccessPackageResource struct{}
+
+func TestAccAccessPackageIncompatibleAccessPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_incompatible_access_package", "test")
+	r := AccessPackageIncompatibleAccessPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageIncompatibleAccessPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_incompatible_access_package", "test")
+	r := AccessPackageIncompatibleAccessPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (AccessPackageIncompatibleAccessPackageResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessPackageIncompatibleAccessPackageClient
+
+	id, err := parse.AccessPackageIncompatibleAccessPackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(id.AccessPackageId)
+
+	resp, err := client.ListEntitlementManagementAccessPackageIncompatibleAccessPackages(ctx, accessPackageId, entitlementmanagementaccesspackageincompatibleaccesspackage.DefaultListEntitlementManagementAccessPackageIncompatibleAccessPackagesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list incompatible access packages for %s: %+v", accessPackageId, err)
+	}
+
+	for _, accessPackage := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(accessPackage.Id), id.IncompatibleAccessPackageId) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (AccessPackageIncompatibleAccessPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "test-catalog-%[1]d"
+  description  = "Test Catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "access-package-%[1]d"
+  description  = "Test Access Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package" "incompatible" {
+  display_name = "access-package-incompatible-%[1]d"
+  description  = "Test Incompatible Access Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package_incompatible_access_package" "test" {
+  access_package_id              = azuread_access_package.test.id
+  incompatible_access_package_id = azuread_access_package.incompatible.id
+}
+`, data.RandomInteger)
+}
+
+func (r AccessPackageIncompatibleAccessPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_incompatible_access_package" "import" {
+  access_package_id              = azuread_access_package_incompatible_access_package.test.access_package_id
+  incompatible_access_package_id = azuread_access_package_incompatible_access_package.test.incompatible_access_package_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatiblegroup"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/validate"
+)
+
+func accessPackageIncompatibleGroupResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessPackageIncompatibleGroupResourceCreate,
+		ReadContext:   accessPackageIncompatibleGroupResourceRead,
+		DeleteContext: accessPackageIncompatibleGroupResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validate.AccessPackageIncompatibleGroupID),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"access_package_id": {
+				Description:  "The ID of the access package",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"group_id": {
+				Description:  "The object ID of the group which is incompatible with the access package. Members of this group cannot request the access package",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func accessPackageIncompatibleGroupResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleGroupClient
+
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(d.Get("access_package_id").(string))
+	groupId := stable.NewGroupID(d.Get("group_id").(string))
+	resourceId := parse.NewAccessPackageIncompatibleGroupID(accessPackageId.AccessPackageId, groupId.GroupId)
+
+	tf.LockByName(accessPackageResourceName, accessPackageId.AccessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, accessPackageId.AccessPackageId)
+
+	existing, err := accessPackageGetIncompatibleGroup(ctx, client, resourceId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing incompatible groups for %s", accessPackageId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_access_package_incompatible_group", resourceId.ID())
+	}
+
+	properties := stable.ReferenceCreate{
+		ODataId: pointer.To(client.Client.BaseUri + groupId.ID()),
+	}
+
+	if _, err = client.AddEntitlementManagementAccessPackageIncompatibleGroupRef(ctx, accessPackageId, properties, entitlementmanagementaccesspackageincompatiblegroup.DefaultAddEntitlementManagementAccessPackageIncompatibleGroupRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Adding incompatible group %q to %s", groupId.GroupId, accessPackageId)
+	}
+
+	d.SetId(resourceId.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		incompatibleGroup, err := accessPackageGetIncompatibleGroup(ctx, client, resourceId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(incompatibleGroup != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for incompatible group %q to be added to %s", groupId.GroupId, accessPackageId)
+	}
+
+	return accessPackageIncompatibleGroupResourceRead(ctx, d, meta)
+}
+
+func accessPackageIncompatibleGroupResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleGroupClient
+
+	resourceId, err := parse.AccessPackageIncompatibleGroupID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	incompatibleGroup, err := accessPackageGetIncompatibleGroup(ctx, client, *resourceId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving incompatible group %q for access package %q", resourceId.GroupId, resourceId.AccessPackageId)
+	}
+	if incompatibleGroup == nil {
+		log.Printf("[DEBUG] Incompatible group %q for access package %q was not found - removing from state!", resourceId.GroupId, resourceId.AccessPackageId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "access_package_id", resourceId.AccessPackageId)
+	tf.Set(d, "group_id", resourceId.GroupId)
+
+	return nil
+}
+
+func accessPackageIncompatibleGroupResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageIncompatibleGroupClient
+
+	resourceId, err := parse.AccessPackageIncompatibleGroupID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Failed to parse resource ID %q", d.Id())
+	}
+
+	tf.LockByName(accessPackageResourceName, resourceId.AccessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, resourceId.AccessPackageId)
+
+	id := stable.NewIdentityGovernanceEntitlementManagementAccessPackageIdIncompatibleGroupID(resourceId.AccessPackageId, resourceId.GroupId)
+
+	if _, err = client.RemoveEntitlementManagementAccessPackageIncompatibleGroupRef(ctx, id, entitlementmanagementaccesspackageincompatiblegroup.DefaultRemoveEntitlementManagementAccessPackageIncompatibleGroupRefOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Removing %s", id)
+	}
+
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		incompatibleGroup, err := accessPackageGetIncompatibleGroup(ctx, client, *resourceId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(incompatibleGroup != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
+
+// accessPackageGetIncompatibleGroup returns the incompatible group referenced by the specified ID, or nil if the
+// group is not marked as incompatible or the access package no longer exists.
+func accessPackageGetIncompatibleGroup(ctx context.Context, client *entitlementmanagementaccesspackageincompatiblegroup.EntitlementManagementAccessPackageIncompatibleGroupClient, id parse.AccessPackageIncompatibleGroupId) (*stable.Group, error) {
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(id.AccessPackageId)
+
+	resp, err := client.ListEntitlementManagementAccessPackageIncompatibleGroups(ctx, accessPackageId, entitlementmanagementaccesspackageincompatiblegroup.DefaultListEntitlementManagementAccessPackageIncompatibleGroupsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing incompatible groups for %s: %v", accessPackageId, err)
+	}
+
+	for _, group := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(group.Id), id.GroupId) {
+			return &group, nil
+		}
+	}
+
+	return nil, nil
+}
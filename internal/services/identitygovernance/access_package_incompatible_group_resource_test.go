@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatiblegroup"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+)
+
+type AccessPackageIncompatibleGroupResource struct{}
+
+func TestAccAccessPackageIncompatibleGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_incompatible_group", "test")
+	r := AccessPackageIncompatibleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageIncompatibleGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_incompatible_group", "test")
+	r := AccessPackageIncompatibleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (AccessPackageIncompatibleGroupResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessPackageIncompatibleGroupClient
+
+	id, err := parse.AccessPackageIncompatibleGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPackageId := stable.NewIdentityGovernanceEntitlementManagementAccessPackageID(id.AccessPackageId)
+
+	resp, err := client.ListEntitlementManagementAccessPackageIncompatibleGroups(ctx, accessPackageId, entitlementmanagementaccesspackageincompatiblegroup.DefaultListEntitlementManagementAccessPackageIncompatibleGroupsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list incompatible groups for %s: %+v", accessPackageId, err)
+	}
+
+	for _, group := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(group.Id), id.GroupId) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (AccessPackageIncompatibleGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "test-catalog-%[1]d"
+  description  = "Test Catalog %[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "access-package-%[1]d"
+  description  = "Test Access Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_access_package_incompatible_group" "test" {
+  access_package_id = azuread_access_package.test.id
+  group_id          = azuread_group.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (r AccessPackageIncompatibleGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_incompatible_group" "import" {
+  access_package_id = azuread_access_package_incompatible_group.test.access_package_id
+  group_id          = azuread_access_package_incompatible_group.test.group_id
+}
+`, r.basic(data))
+}
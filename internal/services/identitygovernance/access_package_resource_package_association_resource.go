@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -57,15 +59,12 @@ func accessPackageResourcePackageAssociationResource() *pluginsdk.Resource {
 			},
 
 			"access_type": {
-				Description: "The role of access type to the specified resource, valid values are `Member` and `Owner`",
-				Type:        pluginsdk.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "Member",
-				ValidateFunc: validation.StringInSlice([]string{
-					"Member",
-					"Owner",
-				}, false),
+				Description:  "The role of access type to the specified resource, such as `Member` or `Owner` for groups, or the name of an application role",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Member",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]+$`), "must not be empty or contain a `/` character"),
 			},
 		},
 	}
@@ -75,6 +74,7 @@ func accessPackageResourcePackageAssociationResourceCreate(ctx context.Context,
 	client := meta.(*clients.Client).IdentityGovernance.AccessPackageResourceRoleScopeClient
 	accessPackageClient := meta.(*clients.Client).IdentityGovernance.AccessPackageClient
 	resourceClient := meta.(*clients.Client).IdentityGovernance.AccessPackageCatalogResourceClient
+	roleClient := meta.(*clients.Client).IdentityGovernance.AccessPackageCatalogResourceRoleClient
 
 	catalogResourceAssociationId, err := parse.AccessPackageResourceCatalogAssociationID(d.Get("catalog_resource_association_id").(string))
 	if err != nil {
@@ -99,10 +99,34 @@ func accessPackageResourcePackageAssociationResourceCreate(ctx context.Context,
 
 	resource := pointer.To((*resourceResp.Model)[0])
 
+	// SharePoint sites are identified by their URL, which cannot be represented in the resource ID
+	if strings.EqualFold(resource.OriginSystem.GetOrZero(), "SharePointOnline") {
+		return tf.ErrorDiagPathF(errors.New("SharePoint Online site resources are not supported"), "catalog_resource_association_id", "Resource %q in %s", catalogResourceAssociationId.OriginId, catalogId)
+	}
+
+	// Look up the roles available for the resource, so that the access type can be validated and mapped to a role
+	roles, err := GetAccessPackageResourceRoles(ctx, roleClient, catalogId, *resource)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving available roles for resource %q in %s", catalogResourceAssociationId.OriginId, catalogId)
+	}
+
+	var role *beta.AccessPackageResourceRole
+	availableRoles := make([]string, 0)
+	for _, v := range *roles {
+		if strings.EqualFold(v.DisplayName.GetOrZero(), accessType) || strings.EqualFold(v.OriginId.GetOrZero(), accessType) {
+			role = pointer.To(v)
+			break
+		}
+		availableRoles = append(availableRoles, v.DisplayName.GetOrZero())
+	}
+	if role == nil {
+		return tf.ErrorDiagPathF(fmt.Errorf("available roles are: %s", strings.Join(availableRoles, ", ")), "access_type", "Role %q is not available for resource %q", accessType, catalogResourceAssociationId.OriginId)
+	}
+
 	properties := beta.AccessPackageResourceRoleScope{
 		AccessPackageResourceRole: &beta.AccessPackageResourceRole{
-			DisplayName:  nullable.NoZero(accessType),
-			OriginId:     nullable.Value(fmt.Sprintf("%s_%s", accessType, catalogResourceAssociationId.OriginId)),
+			DisplayName:  role.DisplayName,
+			OriginId:     role.OriginId,
 			OriginSystem: resource.OriginSystem,
 			AccessPackageResource: &beta.AccessPackageResource{
 				Id:           resource.Id,
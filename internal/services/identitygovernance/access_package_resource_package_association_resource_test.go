@@ -36,6 +36,22 @@ func TestAccAccessPackageResourcePackageAssociation_complete(t *testing.T) {
 	})
 }
 
+func TestAccAccessPackageResourcePackageAssociation_appRole(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_package_association", "test")
+	r := AccessPackageResourcePackageAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.appRole(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_type").HasValue("Reader"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (AccessPackageResourcePackageAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.IdentityGovernance.AccessPackageClient
 
@@ -86,3 +102,49 @@ resource "azuread_access_package_resource_package_association" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (AccessPackageResourcePackageAssociationResource) appRole(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Readers of the application"
+    display_name         = "Reader"
+    enabled              = true
+    id                   = "%[2]s"
+    value                = "Reader"
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_access_package_catalog" "test_catalog" {
+  display_name = "test-catalog-%[1]d"
+  description  = "Test catalog %[1]d"
+}
+
+resource "azuread_access_package_resource_catalog_association" "test" {
+  catalog_id             = azuread_access_package_catalog.test_catalog.id
+  resource_origin_id     = azuread_service_principal.test.object_id
+  resource_origin_system = "AadApplication"
+}
+
+resource "azuread_access_package" "test" {
+  display_name = "test-package-%[1]d"
+  description  = "Test Package %[1]d"
+  catalog_id   = azuread_access_package_catalog.test_catalog.id
+}
+
+resource "azuread_access_package_resource_package_association" "test" {
+  access_package_id               = azuread_access_package.test.id
+  catalog_resource_association_id = azuread_access_package_resource_catalog_association.test.id
+  access_type                     = "Reader"
+}
+`, data.RandomInteger, data.RandomID)
+}
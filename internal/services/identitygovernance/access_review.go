@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accessreviewdefinition"
+)
+
+func expandAccessReviewReviewerScopes(input []interface{}) *[]stable.AccessReviewReviewerScope {
+	result := make([]stable.AccessReviewReviewerScope, 0)
+
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		result = append(result, stable.AccessReviewReviewerScope{
+			Query:     nullable.NoZero(v["query"].(string)),
+			QueryRoot: nullable.NoZero(v["query_root"].(string)),
+			QueryType: nullable.NoZero(v["query_type"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenAccessReviewReviewerScopes(input *[]stable.AccessReviewReviewerScope) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, map[string]interface{}{
+			"query":      v.Query.GetOrZero(),
+			"query_root": v.QueryRoot.GetOrZero(),
+			"query_type": v.QueryType.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+func expandAccessReviewQueryScopes(input []interface{}) *[]accessreviewdefinition.AccessReviewScope {
+	result := make([]accessreviewdefinition.AccessReviewScope, 0)
+
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		result = append(result, accessreviewdefinition.AccessReviewScope{
+			ODataType: pointer.To(accessreviewdefinition.ODataTypeAccessReviewQueryScope),
+			Query:     nullable.NoZero(v["query"].(string)),
+			QueryRoot: nullable.NoZero(v["query_root"].(string)),
+			QueryType: nullable.NoZero(v["query_type"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenAccessReviewQueryScopes(input *[]accessreviewdefinition.AccessReviewScope) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, map[string]interface{}{
+			"query":      v.Query.GetOrZero(),
+			"query_root": v.QueryRoot.GetOrZero(),
+			"query_type": v.QueryType.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+// expandAccessReviewScope builds the scope of an access review. Scopes specifying principal and resource scopes are
+// used to review access to resources such as directory roles, and scopes specifying an inactive duration only include
+// users who have not signed in during that period.
+func expandAccessReviewScope(input []interface{}) *accessreviewdefinition.AccessReviewScope {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	in := input[0].(map[string]interface{})
+
+	if resourceScopes := in["resource_scope"].([]interface{}); len(resourceScopes) > 0 {
+		return &accessreviewdefinition.AccessReviewScope{
+			ODataType:       pointer.To(accessreviewdefinition.ODataTypePrincipalResourceMembershipsScope),
+			PrincipalScopes: expandAccessReviewQueryScopes(in["principal_scope"].([]interface{})),
+			ResourceScopes:  expandAccessReviewQueryScopes(resourceScopes),
+		}
+	}
+
+	queryType := in["query_type"].(string)
+	if queryType == "" {
+		queryType = AccessReviewQueryTypeMicrosoftGraph
+	}
+
+	result := accessreviewdefinition.AccessReviewScope{
+		ODataType: pointer.To(accessreviewdefinition.ODataTypeAccessReviewQueryScope),
+		Query:     nullable.NoZero(in["query"].(string)),
+		QueryRoot: nullable.NoZero(in["query_root"].(string)),
+		QueryType: nullable.Value(queryType),
+	}
+
+	if inactiveDuration := in["inactive_duration"].(string); inactiveDuration != "" {
+		result.ODataType = pointer.To(accessreviewdefinition.ODataTypeAccessReviewInactiveUsersQueryScope)
+		result.InactiveDuration = nullable.Value(inactiveDuration)
+	}
+
+	return &result
+}
+
+func flattenAccessReviewScope(input *accessreviewdefinition.AccessReviewScope) []map[string]interface{} {
+	if input == nil {
+		return []map[string]interface{}{}
+	}
+
+	if pointer.From(input.ODataType) == accessreviewdefinition.ODataTypePrincipalResourceMembershipsScope {
+		return []map[string]interface{}{{
+			"principal_scope": flattenAccessReviewQueryScopes(input.PrincipalScopes),
+			"resource_scope":  flattenAccessReviewQueryScopes(input.ResourceScopes),
+		}}
+	}
+
+	return []map[string]interface{}{{
+		"inactive_duration": input.InactiveDuration.GetOrZero(),
+		"query":             input.Query.GetOrZero(),
+		"query_root":        input.QueryRoot.GetOrZero(),
+		"query_type":        input.QueryType.GetOrZero(),
+	}}
+}
+
+func expandAccessReviewInstanceEnumerationScope(input []interface{}) *accessreviewdefinition.AccessReviewScope {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	scopes := expandAccessReviewQueryScopes(input)
+	if len(*scopes) == 0 {
+		return nil
+	}
+
+	return &(*scopes)[0]
+}
+
+func flattenAccessReviewInstanceEnumerationScope(input *accessreviewdefinition.AccessReviewScope) []map[string]interface{} {
+	if input == nil || input.Query.GetOrZero() == "" {
+		return []map[string]interface{}{}
+	}
+
+	return flattenAccessReviewQueryScopes(&[]accessreviewdefinition.AccessReviewScope{*input})
+}
+
+func expandAccessReviewScheduleSettings(input []interface{}) (*stable.AccessReviewScheduleSettings, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	in := input[0].(map[string]interface{})
+
+	result := stable.AccessReviewScheduleSettings{
+		AutoApplyDecisionsEnabled:            pointer.To(in["auto_apply_decisions_enabled"].(bool)),
+		DecisionHistoriesForReviewersEnabled: nullable.Value(in["decision_histories_for_reviewers_enabled"].(bool)),
+		DefaultDecision:                      nullable.NoZero(in["default_decision"].(string)),
+		DefaultDecisionEnabled:               pointer.To(in["default_decision_enabled"].(bool)),
+		InstanceDurationInDays:               pointer.To(int64(in["instance_duration_in_days"].(int))),
+		JustificationRequiredOnApproval:      pointer.To(in["justification_required_on_approval"].(bool)),
+		MailNotificationsEnabled:             pointer.To(in["mail_notifications_enabled"].(bool)),
+		RecommendationLookBackDuration:       nullable.NoZero(in["recommendation_look_back_duration"].(string)),
+		RecommendationsEnabled:               pointer.To(in["recommendations_enabled"].(bool)),
+		ReminderNotificationsEnabled:         pointer.To(in["reminder_notifications_enabled"].(bool)),
+	}
+
+	switch in["apply_action"].(string) {
+	case AccessReviewApplyActionDisableAndDeleteUser:
+		result.ApplyActions = &[]stable.AccessReviewApplyAction{stable.DisableAndDeleteUserApplyAction{}}
+	case AccessReviewApplyActionRemoveAccess:
+		result.ApplyActions = &[]stable.AccessReviewApplyAction{stable.RemoveAccessApplyAction{}}
+	}
+
+	recurrence, err := expandAccessReviewRecurrence(in["recurrence"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("building `recurrence`: %v", err)
+	}
+	result.Recurrence = recurrence
+
+	return &result, nil
+}
+
+func flattenAccessReviewScheduleSettings(input *stable.AccessReviewScheduleSettings) []map[string]interface{} {
+	if input == nil {
+		return []map[string]interface{}{}
+	}
+
+	applyAction := ""
+	for _, action := range pointer.From(input.ApplyActions) {
+		switch action.(type) {
+		case stable.DisableAndDeleteUserApplyAction:
+			applyAction = AccessReviewApplyActionDisableAndDeleteUser
+		case stable.RemoveAccessApplyAction:
+			applyAction = AccessReviewApplyActionRemoveAccess
+		}
+	}
+
+	return []map[string]interface{}{{
+		"apply_action":                             applyAction,
+		"auto_apply_decisions_enabled":             pointer.From(input.AutoApplyDecisionsEnabled),
+		"decision_histories_for_reviewers_enabled": input.DecisionHistoriesForReviewersEnabled.GetOrZero(),
+		"default_decision":                         input.DefaultDecision.GetOrZero(),
+		"default_decision_enabled":                 pointer.From(input.DefaultDecisionEnabled),
+		"instance_duration_in_days":                pointer.From(input.InstanceDurationInDays),
+		"justification_required_on_approval":       pointer.From(input.JustificationRequiredOnApproval),
+		"mail_notifications_enabled":               pointer.From(input.MailNotificationsEnabled),
+		"recommendation_look_back_duration":        input.RecommendationLookBackDuration.GetOrZero(),
+		"recommendations_enabled":                  pointer.From(input.RecommendationsEnabled),
+		"recurrence":                               flattenAccessReviewRecurrence(input.Recurrence),
+		"reminder_notifications_enabled":           pointer.From(input.ReminderNotificationsEnabled),
+	}}
+}
+
+func expandAccessReviewRecurrence(input []interface{}) (*stable.PatternedRecurrence, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	in := input[0].(map[string]interface{})
+
+	result := stable.PatternedRecurrence{
+		Range: &stable.RecurrenceRange{
+			EndDate:   nullable.NoZero(in["end_date"].(string)),
+			StartDate: nullable.NoZero(in["start_date"].(string)),
+			Type:      stable.RecurrenceRangeType(in["range_type"].(string)),
+		},
+	}
+
+	if result.Range.Type == stable.RecurrenceRangeType_EndDate && result.Range.EndDate.GetOrZero() == "" {
+		return nil, fmt.Errorf("`end_date` must be specified when `range_type` is %q", stable.RecurrenceRangeType_EndDate)
+	}
+
+	if result.Range.Type == stable.RecurrenceRangeType_Numbered {
+		result.Range.NumberOfOccurrences = pointer.To(int64(in["number_of_occurrences"].(int)))
+	}
+
+	if patternType := in["pattern_type"].(string); patternType != "" {
+		result.Pattern = &stable.RecurrencePattern{
+			Interval: int64(in["interval"].(int)),
+			Type:     stable.RecurrencePatternType(patternType),
+		}
+
+		if dayOfMonth := in["day_of_month"].(int); dayOfMonth > 0 {
+			result.Pattern.DayOfMonth = pointer.To(int64(dayOfMonth))
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenAccessReviewRecurrence(input *stable.PatternedRecurrence) []map[string]interface{} {
+	if input == nil || input.Range == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"day_of_month":          0,
+		"end_date":              input.Range.EndDate.GetOrZero(),
+		"interval":              1,
+		"number_of_occurrences": pointer.From(input.Range.NumberOfOccurrences),
+		"pattern_type":          "",
+		"range_type":            string(input.Range.Type),
+		"start_date":            input.Range.StartDate.GetOrZero(),
+	}
+
+	if pattern := input.Pattern; pattern != nil {
+		result["day_of_month"] = pointer.From(pattern.DayOfMonth)
+		result["interval"] = pattern.Interval
+		result["pattern_type"] = string(pattern.Type)
+	}
+
+	return []map[string]interface{}{result}
+}
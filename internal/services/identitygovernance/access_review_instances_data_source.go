@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accessreviewdefinition"
+)
+
+func accessReviewInstancesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: accessReviewInstancesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"schedule_definition_id": {
+				Description:  "The ID of the access review schedule definition",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"status": {
+				Description:  "Only return review instances with this status",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"instances": {
+				Description: "The review instances of the access review schedule definition",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the review instance",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"status": {
+							Description: "The status of the review instance",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date_time": {
+							Description: "The date and time when the review instance starts",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date_time": {
+							Description: "The date and time when the review instance ends",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"scope_query": {
+							Description: "The query specifying the entities whose access is reviewed in this review instance",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessReviewInstancesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessReviewDefinitionClient
+
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(d.Get("schedule_definition_id").(string))
+
+	options := accessreviewdefinition.DefaultListAccessReviewDefinitionInstancesOperationOptions()
+	if status := d.Get("status").(string); status != "" {
+		options.Filter = pointer.To(fmt.Sprintf("status eq '%s'", odata.EscapeSingleQuote(status)))
+	}
+
+	resp, err := client.ListAccessReviewDefinitionInstances(ctx, id, options)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "schedule_definition_id", "Listing review instances for %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Bad API Response")
+	}
+
+	instanceIds := make([]string, 0)
+	instances := make([]map[string]interface{}, 0)
+	for _, instance := range *resp.Model {
+		instanceId := pointer.From(instance.Id)
+		instanceIds = append(instanceIds, instanceId)
+
+		scopeQuery := ""
+		if instance.Scope != nil {
+			scopeQuery = instance.Scope.Query.GetOrZero()
+		}
+
+		instances = append(instances, map[string]interface{}{
+			"id":              instanceId,
+			"status":          instance.Status.GetOrZero(),
+			"start_date_time": instance.StartDateTime.GetOrZero(),
+			"end_date_time":   instance.EndDateTime.GetOrZero(),
+			"scope_query":     scopeQuery,
+		})
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(id.AccessReviewScheduleDefinitionId + "/" + strings.Join(instanceIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for review instance IDs")
+	}
+
+	d.SetId("accessReviewInstances#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "instances", instances)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AccessReviewInstancesDataSource struct{}
+
+func TestAccAccessReviewInstancesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_review_instances", "test")
+	r := AccessReviewInstancesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("instances.#").Exists(),
+			),
+		},
+	})
+}
+
+func (AccessReviewInstancesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_access_review_instances" "test" {
+  schedule_definition_id = azuread_access_review_schedule_definition.test.id
+}
+`, AccessReviewScheduleDefinitionResource{}.basic(data))
+}
@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accessreviewdefinition"
+)
+
+const accessReviewScheduleDefinitionResourceName = "azuread_access_review_schedule_definition"
+
+func accessReviewScheduleDefinitionResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessReviewScheduleDefinitionResourceCreate,
+		ReadContext:   accessReviewScheduleDefinitionResourceRead,
+		UpdateContext: accessReviewScheduleDefinitionResourceUpdate,
+		DeleteContext: accessReviewScheduleDefinitionResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the access review",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description_for_admins": {
+				Description:  "The description of the access review, shown to administrators",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description_for_reviewers": {
+				Description:  "The description of the access review, shown to reviewers in the review notification email",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"scope": {
+				Description: "The entities whose access is reviewed",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query": {
+							Description:  "The query specifying the entities whose access is reviewed",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"scope.0.query", "scope.0.resource_scope"},
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"query_type": {
+							Description:  "The type of query",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"query_root": {
+							Description:  "The root from which the query is evaluated",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"inactive_duration": {
+							Description:   "Only review users who have not signed in during this period, as an ISO8601 duration (e.g. P30D)",
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"scope.0.resource_scope"},
+							ValidateFunc:  validation.StringIsNotEmpty,
+						},
+
+						"principal_scope": {
+							Description:  "The principals whose access to the resources in `resource_scope` is reviewed",
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"scope.0.resource_scope"},
+							Elem:         schemaAccessReviewQueryScope(),
+						},
+
+						"resource_scope": {
+							Description: "The resources, such as directory roles, to which access is reviewed",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        schemaAccessReviewQueryScope(),
+						},
+					},
+				},
+			},
+
+			"instance_enumeration_scope": {
+				Description: "The groups to review when reviewing guest access across all Microsoft 365 groups. Each group becomes a separate review instance",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem:        schemaAccessReviewQueryScope(),
+			},
+
+			"reviewer": {
+				Description: "The reviewers for this access review. When no reviewers are specified, users review their own access",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem:        schemaAccessReviewQueryScope(),
+			},
+
+			"fallback_reviewer": {
+				Description: "The reviewers who are notified when no reviewers can be found, for example when a user has no manager",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem:        schemaAccessReviewQueryScope(),
+			},
+
+			"settings": {
+				Description: "The settings for the access review",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"instance_duration_in_days": {
+							Description:  "The duration of each review instance, in days",
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"recurrence": {
+							Description: "The schedule on which the review recurs",
+							Type:        pluginsdk.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"start_date": {
+										Description:  "The date on which the first review starts, formatted as YYYY-MM-DD",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"range_type": {
+										Description:  "The type of recurrence range",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForRecurrenceRangeType(), false),
+									},
+
+									"end_date": {
+										Description:  "The date after which the review no longer recurs, formatted as YYYY-MM-DD. Required when `range_type` is `endDate`",
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"number_of_occurrences": {
+										Description:  "The number of times the review recurs, when `range_type` is `numbered`",
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"pattern_type": {
+										Description: "The frequency at which the review recurs",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(stable.RecurrencePatternType_AbsoluteMonthly),
+											string(stable.RecurrencePatternType_Weekly),
+										}, false),
+									},
+
+									"interval": {
+										Description:  "The number of weeks or months between each recurrence",
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"day_of_month": {
+										Description:  "The day of the month on which the review recurs, when `pattern_type` is `absoluteMonthly`",
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 31),
+									},
+								},
+							},
+						},
+
+						"apply_action": {
+							Description:  "The action to take on denied or unreviewed access when decisions are applied",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(possibleValuesForAccessReviewApplyAction, false),
+						},
+
+						"auto_apply_decisions_enabled": {
+							Description: "Whether decisions are automatically applied when each review instance ends",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"decision_histories_for_reviewers_enabled": {
+							Description: "Whether reviewers can see the decisions made in earlier stages of the review",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"default_decision_enabled": {
+							Description: "Whether the default decision is applied when reviewers do not respond",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"default_decision": {
+							Description:  "The decision which is applied when reviewers do not respond",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(possibleValuesForAccessReviewDefaultDecision, false),
+						},
+
+						"justification_required_on_approval": {
+							Description: "Whether reviewers must provide a justification when approving access",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"mail_notifications_enabled": {
+							Description: "Whether email notifications are sent to reviewers",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"reminder_notifications_enabled": {
+							Description: "Whether reminder emails are sent to reviewers",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"recommendations_enabled": {
+							Description: "Whether decision recommendations are shown to reviewers",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"recommendation_look_back_duration": {
+							Description:  "The period of inactivity, as an ISO8601 duration (e.g. P30D), after which a recommendation to deny access is made",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Description: "The status of the access review",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessReviewScheduleDefinitionResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessReviewDefinitionClient
+
+	properties, err := expandAccessReviewScheduleDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building access review schedule definition")
+	}
+
+	resp, err := client.CreateAccessReviewDefinition(ctx, *properties, accessreviewdefinition.DefaultCreateAccessReviewDefinitionOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating access review schedule definition")
+	}
+
+	definition := resp.Model
+	if definition == nil || definition.Id == nil {
+		return tf.ErrorDiagF(errors.New("model or ID was nil"), "Creating access review schedule definition")
+	}
+
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(*definition.Id)
+	d.SetId(id.AccessReviewScheduleDefinitionId)
+
+	// Wait for the definition to be consistently readable
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAccessReviewDefinition(ctx, id, accessreviewdefinition.DefaultGetAccessReviewDefinitionOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return accessReviewScheduleDefinitionResourceRead(ctx, d, meta)
+}
+
+func accessReviewScheduleDefinitionResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessReviewDefinitionClient
+
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(d.Id())
+
+	tf.LockByName(accessReviewScheduleDefinitionResourceName, id.AccessReviewScheduleDefinitionId)
+	defer tf.UnlockByName(accessReviewScheduleDefinitionResourceName, id.AccessReviewScheduleDefinitionId)
+
+	properties, err := expandAccessReviewScheduleDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building %s", id)
+	}
+
+	if _, err = client.SetAccessReviewDefinition(ctx, id, *properties, accessreviewdefinition.DefaultSetAccessReviewDefinitionOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return accessReviewScheduleDefinitionResourceRead(ctx, d, meta)
+}
+
+func accessReviewScheduleDefinitionResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessReviewDefinitionClient
+
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(d.Id())
+
+	resp, err := client.GetAccessReviewDefinition(ctx, id, accessreviewdefinition.DefaultGetAccessReviewDefinitionOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	definition := resp.Model
+	if definition == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "description_for_admins", definition.DescriptionForAdmins.GetOrZero())
+	tf.Set(d, "description_for_reviewers", definition.DescriptionForReviewers.GetOrZero())
+	tf.Set(d, "display_name", definition.DisplayName.GetOrZero())
+	tf.Set(d, "fallback_reviewer", flattenAccessReviewReviewerScopes(definition.FallbackReviewers))
+	tf.Set(d, "instance_enumeration_scope", flattenAccessReviewInstanceEnumerationScope(definition.InstanceEnumerationScope))
+	tf.Set(d, "reviewer", flattenAccessReviewReviewerScopes(definition.Reviewers))
+	tf.Set(d, "scope", flattenAccessReviewScope(definition.Scope))
+	tf.Set(d, "settings", flattenAccessReviewScheduleSettings(definition.Settings))
+	tf.Set(d, "status", definition.Status.GetOrZero())
+
+	return nil
+}
+
+func accessReviewScheduleDefinitionResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessReviewDefinitionClient
+
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(d.Id())
+
+	if _, err := client.DeleteAccessReviewDefinition(ctx, id, accessreviewdefinition.DefaultDeleteAccessReviewDefinitionOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	// Wait for object to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetAccessReviewDefinition(ctx, id, accessreviewdefinition.DefaultGetAccessReviewDefinitionOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandAccessReviewScheduleDefinition(d *pluginsdk.ResourceData) (*accessreviewdefinition.AccessReviewScheduleDefinition, error) {
+	settings, err := expandAccessReviewScheduleSettings(d.Get("settings").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("building `settings`: %v", err)
+	}
+
+	return &accessreviewdefinition.AccessReviewScheduleDefinition{
+		DescriptionForAdmins:     nullable.NoZero(d.Get("description_for_admins").(string)),
+		DescriptionForReviewers:  nullable.NoZero(d.Get("description_for_reviewers").(string)),
+		DisplayName:              nullable.Value(d.Get("display_name").(string)),
+		FallbackReviewers:        expandAccessReviewReviewerScopes(d.Get("fallback_reviewer").([]interface{})),
+		InstanceEnumerationScope: expandAccessReviewInstanceEnumerationScope(d.Get("instance_enumeration_scope").([]interface{})),
+		Reviewers:                expandAccessReviewReviewerScopes(d.Get("reviewer").([]interface{})),
+		Scope:                    expandAccessReviewScope(d.Get("scope").([]interface{})),
+		Settings:                 settings,
+	}, nil
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accessreviewdefinition"
+)
+
+type AccessReviewScheduleDefinitionResource struct{}
+
+func TestAccAccessReviewScheduleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_review_schedule_definition", "test")
+	r := AccessReviewScheduleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessReviewScheduleDefinition_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_review_schedule_definition", "test")
+	r := AccessReviewScheduleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reviewer.#").HasValue("1"),
+				check.That(data.ResourceName).Key("fallback_reviewer.#").HasValue("1"),
+				check.That(data.ResourceName).Key("settings.0.apply_action").HasValue("removeAccess"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessReviewScheduleDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_review_schedule_definition", "test")
+	r := AccessReviewScheduleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessReviewScheduleDefinition_inactiveGuests(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_review_schedule_definition", "test")
+	r := AccessReviewScheduleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inactiveGuests(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope.0.inactive_duration").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessReviewScheduleDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessReviewDefinitionClient
+	id := stable.NewIdentityGovernanceAccessReviewDefinitionID(state.ID)
+
+	if resp, err := client.GetAccessReviewDefinition(ctx, id, accessreviewdefinition.DefaultGetAccessReviewDefinitionOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AccessReviewScheduleDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+  owners           = [data.azuread_client_config.test.object_id]
+  members          = [azuread_user.test.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r AccessReviewScheduleDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_review_schedule_definition" "test" {
+  display_name = "acctest-AccessReview-%[2]d"
+
+  scope {
+    query = "/groups/${azuread_group.test.object_id}/transitiveMembers"
+  }
+
+  reviewer {
+    query = "/users/${data.azuread_client_config.test.object_id}"
+  }
+
+  settings {
+    instance_duration_in_days = 3
+
+    recurrence {
+      start_date = "%[3]s"
+      range_type = "noEnd"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, time.Now().UTC().Format(time.DateOnly))
+}
+
+func (r AccessReviewScheduleDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_review_schedule_definition" "test" {
+  display_name              = "acctest-AccessReview-%[2]d-updated"
+  description_for_admins    = "Quarterly review of group membership"
+  description_for_reviewers = "Please confirm whether each member still needs access"
+
+  scope {
+    query = "/groups/${azuread_group.test.object_id}/transitiveMembers"
+  }
+
+  reviewer {
+    query      = "./manager"
+    query_root = "decisions"
+  }
+
+  fallback_reviewer {
+    query = "/users/${data.azuread_client_config.test.object_id}"
+  }
+
+  settings {
+    instance_duration_in_days                = 7
+    apply_action                             = "removeAccess"
+    auto_apply_decisions_enabled             = true
+    decision_histories_for_reviewers_enabled = true
+    default_decision_enabled                 = true
+    default_decision                         = "Recommendation"
+    justification_required_on_approval       = true
+    mail_notifications_enabled               = true
+    reminder_notifications_enabled           = true
+    recommendations_enabled                  = true
+    recommendation_look_back_duration        = "P30D"
+
+    recurrence {
+      start_date            = "%[3]s"
+      range_type            = "numbered"
+      number_of_occurrences = 4
+      pattern_type          = "absoluteMonthly"
+      interval              = 3
+      day_of_month          = 1
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, time.Now().UTC().Format(time.DateOnly))
+}
+
+func (r AccessReviewScheduleDefinitionResource) inactiveGuests(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_review_schedule_definition" "test" {
+  display_name = "acctest-AccessReview-%[2]d"
+
+  scope {
+    query             = "/groups/${azuread_group.test.object_id}/transitiveMembers/microsoft.graph.user/?$count=true&$filter=(userType eq 'Guest')"
+    inactive_duration = "P30D"
+  }
+
+  reviewer {
+    query = "/users/${data.azuread_client_config.test.object_id}"
+  }
+
+  settings {
+    instance_duration_in_days = 3
+
+    recurrence {
+      start_date = "%[3]s"
+      range_type = "noEnd"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, time.Now().UTC().Format(time.DateOnly))
+}
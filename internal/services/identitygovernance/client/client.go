@@ -5,6 +5,9 @@ package client
 
 import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accesspackageassignmentrequest"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/accessreviewdefinition"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/termsofuseagreementupload"
 
 	// Beta clients
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
@@ -12,28 +15,57 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageassignmentpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalog"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalogaccesspackageresource"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalogaccesspackageresourcerole"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageresourcerequest"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/beta/entitlementmanagementroleassignment"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/beta/entitlementmanagementroledefinition"
 
 	// Stable clients
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatibleaccesspackage"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementaccesspackageincompatiblegroup"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementassignmentpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementcatalogcustomworkflowextension"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganizationexternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganizationinternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowtaskdefinition"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowworkflow"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedule"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentscheduleinstance"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedulerequest"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityschedule"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityscheduleinstance"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreementacceptance"
 )
 
 type Client struct {
-	AccessPackageAssignmentPolicyClient  *entitlementmanagementaccesspackageassignmentpolicy.EntitlementManagementAccessPackageAssignmentPolicyClient
-	AccessPackageCatalogClient           *entitlementmanagementaccesspackagecatalog.EntitlementManagementAccessPackageCatalogClient
-	AccessPackageCatalogResourceClient   *entitlementmanagementaccesspackagecatalogaccesspackageresource.EntitlementManagementAccessPackageCatalogAccessPackageResourceClient
-	AccessPackageClient                  *entitlementmanagementaccesspackage.EntitlementManagementAccessPackageClient
-	AccessPackageResourceRequestClient   *entitlementmanagementaccesspackageresourcerequest.EntitlementManagementAccessPackageResourceRequestClient
-	AccessPackageResourceRoleScopeClient *entitlementmanagementaccesspackageaccesspackageresourcerolescope.EntitlementManagementAccessPackageAccessPackageResourceRoleScopeClient
-	RoleAssignmentClient                 *entitlementmanagementroleassignment.EntitlementManagementRoleAssignmentClient
-	RoleDefinitionClient                 *entitlementmanagementroledefinition.EntitlementManagementRoleDefinitionClient
+	AccessPackageAssignmentClient                *entitlementmanagementassignment.EntitlementManagementAssignmentClient
+	AccessPackageAssignmentPolicyClient          *entitlementmanagementaccesspackageassignmentpolicy.EntitlementManagementAccessPackageAssignmentPolicyClient
+	AccessPackageAssignmentPolicyStableClient    *entitlementmanagementassignmentpolicy.EntitlementManagementAssignmentPolicyClient
+	AccessPackageAssignmentRequestClient         *accesspackageassignmentrequest.AccessPackageAssignmentRequestClient
+	AccessPackageCatalogClient                   *entitlementmanagementaccesspackagecatalog.EntitlementManagementAccessPackageCatalogClient
+	AccessPackageCatalogResourceClient           *entitlementmanagementaccesspackagecatalogaccesspackageresource.EntitlementManagementAccessPackageCatalogAccessPackageResourceClient
+	AccessPackageCatalogResourceRoleClient       *entitlementmanagementaccesspackagecatalogaccesspackageresourcerole.EntitlementManagementAccessPackageCatalogAccessPackageResourceRoleClient
+	AccessPackageClient                          *entitlementmanagementaccesspackage.EntitlementManagementAccessPackageClient
+	AccessPackageCustomWorkflowExtensionClient   *entitlementmanagementcatalogcustomworkflowextension.EntitlementManagementCatalogCustomWorkflowExtensionClient
+	AccessPackageIncompatibleAccessPackageClient *entitlementmanagementaccesspackageincompatibleaccesspackage.EntitlementManagementAccessPackageIncompatibleAccessPackageClient
+	AccessPackageIncompatibleGroupClient         *entitlementmanagementaccesspackageincompatiblegroup.EntitlementManagementAccessPackageIncompatibleGroupClient
+	AccessPackageResourceRequestClient           *entitlementmanagementaccesspackageresourcerequest.EntitlementManagementAccessPackageResourceRequestClient
+	AccessPackageResourceRoleScopeClient         *entitlementmanagementaccesspackageaccesspackageresourcerolescope.EntitlementManagementAccessPackageAccessPackageResourceRoleScopeClient
+	AccessReviewDefinitionClient                 *accessreviewdefinition.AccessReviewDefinitionClient
+	ConnectedOrganizationClient                  *entitlementmanagementconnectedorganization.EntitlementManagementConnectedOrganizationClient
+	ConnectedOrganizationExternalSponsorClient   *entitlementmanagementconnectedorganizationexternalsponsor.EntitlementManagementConnectedOrganizationExternalSponsorClient
+	ConnectedOrganizationInternalSponsorClient   *entitlementmanagementconnectedorganizationinternalsponsor.EntitlementManagementConnectedOrganizationInternalSponsorClient
+	LifecycleWorkflowClient                      *lifecycleworkflowworkflow.LifecycleWorkflowWorkflowClient
+	LifecycleWorkflowTaskDefinitionClient        *lifecycleworkflowtaskdefinition.LifecycleWorkflowTaskDefinitionClient
+	RoleAssignmentClient                         *entitlementmanagementroleassignment.EntitlementManagementRoleAssignmentClient
+	RoleDefinitionClient                         *entitlementmanagementroledefinition.EntitlementManagementRoleDefinitionClient
+	TermsOfUseAgreementAcceptanceClient          *termsofuseagreementacceptance.TermsOfUseAgreementAcceptanceClient
+	TermsOfUseAgreementClient                    *termsofuseagreement.TermsOfUseAgreementClient
+	TermsOfUseAgreementUploadClient              *termsofuseagreementupload.TermsOfUseAgreementUploadClient
 
 	PrivilegedAccessGroupAssignmentScheduleClient          *privilegedaccessgroupassignmentschedule.PrivilegedAccessGroupAssignmentScheduleClient
 	PrivilegedAccessGroupAssignmentScheduleInstanceClient  *privilegedaccessgroupassignmentscheduleinstance.PrivilegedAccessGroupAssignmentScheduleInstanceClient
@@ -44,12 +76,30 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	accessPackageAssignmentClient, err := entitlementmanagementassignment.NewEntitlementManagementAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageAssignmentClient.Client)
+
 	accessPackageAssignmentPolicyClient, err := entitlementmanagementaccesspackageassignmentpolicy.NewEntitlementManagementAccessPackageAssignmentPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(accessPackageAssignmentPolicyClient.Client)
 
+	accessPackageAssignmentPolicyStableClient, err := entitlementmanagementassignmentpolicy.NewEntitlementManagementAssignmentPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageAssignmentPolicyStableClient.Client)
+
+	accessPackageAssignmentRequestClient, err := accesspackageassignmentrequest.NewAccessPackageAssignmentRequestClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageAssignmentRequestClient.Client)
+
 	accessPackageCatalogClient, err := entitlementmanagementaccesspackagecatalog.NewEntitlementManagementAccessPackageCatalogClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -62,12 +112,36 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(accessPackageCatalogResourceClient.Client)
 
+	accessPackageCatalogResourceRoleClient, err := entitlementmanagementaccesspackagecatalogaccesspackageresourcerole.NewEntitlementManagementAccessPackageCatalogAccessPackageResourceRoleClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageCatalogResourceRoleClient.Client)
+
 	accessPackageClient, err := entitlementmanagementaccesspackage.NewEntitlementManagementAccessPackageClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(accessPackageClient.Client)
 
+	accessPackageCustomWorkflowExtensionClient, err := entitlementmanagementcatalogcustomworkflowextension.NewEntitlementManagementCatalogCustomWorkflowExtensionClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageCustomWorkflowExtensionClient.Client)
+
+	accessPackageIncompatibleAccessPackageClient, err := entitlementmanagementaccesspackageincompatibleaccesspackage.NewEntitlementManagementAccessPackageIncompatibleAccessPackageClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageIncompatibleAccessPackageClient.Client)
+
+	accessPackageIncompatibleGroupClient, err := entitlementmanagementaccesspackageincompatiblegroup.NewEntitlementManagementAccessPackageIncompatibleGroupClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessPackageIncompatibleGroupClient.Client)
+
 	accessPackageResourceRequestClient, err := entitlementmanagementaccesspackageresourcerequest.NewEntitlementManagementAccessPackageResourceRequestClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -80,6 +154,42 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(accessPackageResourceRoleScopeClient.Client)
 
+	accessReviewDefinitionClient, err := accessreviewdefinition.NewAccessReviewDefinitionClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(accessReviewDefinitionClient.Client)
+
+	connectedOrganizationClient, err := entitlementmanagementconnectedorganization.NewEntitlementManagementConnectedOrganizationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationClient.Client)
+
+	connectedOrganizationExternalSponsorClient, err := entitlementmanagementconnectedorganizationexternalsponsor.NewEntitlementManagementConnectedOrganizationExternalSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationExternalSponsorClient.Client)
+
+	connectedOrganizationInternalSponsorClient, err := entitlementmanagementconnectedorganizationinternalsponsor.NewEntitlementManagementConnectedOrganizationInternalSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationInternalSponsorClient.Client)
+
+	lifecycleWorkflowClient, err := lifecycleworkflowworkflow.NewLifecycleWorkflowWorkflowClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(lifecycleWorkflowClient.Client)
+
+	lifecycleWorkflowTaskDefinitionClient, err := lifecycleworkflowtaskdefinition.NewLifecycleWorkflowTaskDefinitionClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(lifecycleWorkflowTaskDefinitionClient.Client)
+
 	roleAssignmentClient, err := entitlementmanagementroleassignment.NewEntitlementManagementRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -92,6 +202,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(roleDefinitionClient.Client)
 
+	termsOfUseAgreementClient, err := termsofuseagreement.NewTermsOfUseAgreementClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(termsOfUseAgreementClient.Client)
+
+	termsOfUseAgreementAcceptanceClient, err := termsofuseagreementacceptance.NewTermsOfUseAgreementAcceptanceClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(termsOfUseAgreementAcceptanceClient.Client)
+
+	termsOfUseAgreementUploadClient, err := termsofuseagreementupload.NewTermsOfUseAgreementUploadClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(termsOfUseAgreementUploadClient.Client)
+
 	privilegedAccessGroupAssignmentScheduleClient, err := privilegedaccessgroupassignmentschedule.NewPrivilegedAccessGroupAssignmentScheduleClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -129,14 +257,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(privilegedAccessGroupEligibilityScheduleRequestClient.Client)
 
 	return &Client{
-		AccessPackageAssignmentPolicyClient:  accessPackageAssignmentPolicyClient,
-		AccessPackageCatalogClient:           accessPackageCatalogClient,
-		AccessPackageCatalogResourceClient:   accessPackageCatalogResourceClient,
-		AccessPackageClient:                  accessPackageClient,
-		AccessPackageResourceRequestClient:   accessPackageResourceRequestClient,
-		AccessPackageResourceRoleScopeClient: accessPackageResourceRoleScopeClient,
-		RoleAssignmentClient:                 roleAssignmentClient,
-		RoleDefinitionClient:                 roleDefinitionClient,
+		AccessPackageAssignmentClient:                accessPackageAssignmentClient,
+		AccessPackageAssignmentPolicyClient:          accessPackageAssignmentPolicyClient,
+		AccessPackageAssignmentPolicyStableClient:    accessPackageAssignmentPolicyStableClient,
+		AccessPackageAssignmentRequestClient:         accessPackageAssignmentRequestClient,
+		AccessPackageCatalogClient:                   accessPackageCatalogClient,
+		AccessPackageCatalogResourceClient:           accessPackageCatalogResourceClient,
+		AccessPackageCatalogResourceRoleClient:       accessPackageCatalogResourceRoleClient,
+		AccessPackageClient:                          accessPackageClient,
+		AccessPackageCustomWorkflowExtensionClient:   accessPackageCustomWorkflowExtensionClient,
+		AccessPackageIncompatibleAccessPackageClient: accessPackageIncompatibleAccessPackageClient,
+		AccessPackageIncompatibleGroupClient:         accessPackageIncompatibleGroupClient,
+		AccessPackageResourceRequestClient:           accessPackageResourceRequestClient,
+		AccessPackageResourceRoleScopeClient:         accessPackageResourceRoleScopeClient,
+		AccessReviewDefinitionClient:                 accessReviewDefinitionClient,
+		ConnectedOrganizationClient:                  connectedOrganizationClient,
+		ConnectedOrganizationExternalSponsorClient:   connectedOrganizationExternalSponsorClient,
+		ConnectedOrganizationInternalSponsorClient:   connectedOrganizationInternalSponsorClient,
+		LifecycleWorkflowClient:                      lifecycleWorkflowClient,
+		LifecycleWorkflowTaskDefinitionClient:        lifecycleWorkflowTaskDefinitionClient,
+		RoleAssignmentClient:                         roleAssignmentClient,
+		RoleDefinitionClient:                         roleDefinitionClient,
+		TermsOfUseAgreementAcceptanceClient:          termsOfUseAgreementAcceptanceClient,
+		TermsOfUseAgreementClient:                    termsOfUseAgreementClient,
+		TermsOfUseAgreementUploadClient:              termsOfUseAgreementUploadClient,
 
 		PrivilegedAccessGroupAssignmentScheduleClient:          privilegedAccessGroupAssignmentScheduleClient,
 		PrivilegedAccessGroupAssignmentScheduleInstanceClient:  privilegedAccessGroupAssignmentScheduleInstanceClient,
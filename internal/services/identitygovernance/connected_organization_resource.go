@@ -0,0 +1,413 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganizationexternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganizationinternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+const connectedOrganizationResourceName = "azuread_connected_organization"
+
+func connectedOrganizationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: connectedOrganizationResourceCreate,
+		ReadContext:   connectedOrganizationResourceRead,
+		UpdateContext: connectedOrganizationResourceUpdate,
+		DeleteContext: connectedOrganizationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the connected organization",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description:  "The description of the connected organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"state": {
+				Description:  "The state of the connected organization, which determines whether users from it can request access packages",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(stable.ConnectedOrganizationState_Configured),
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConnectedOrganizationState(), false),
+			},
+
+			"identity_source": {
+				Description: "The identity source of the connected organization, identifying the tenant or domain of its users",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"tenant_id": {
+							Description:  "The tenant ID of the Azure Active Directory tenant of the connected organization",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"identity_source.0.tenant_id", "identity_source.0.domain_name"},
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"domain_name": {
+							Description:  "A domain name of the connected organization",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"identity_source.0.tenant_id", "identity_source.0.domain_name"},
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"display_name": {
+							Description:  "The display name of the identity source",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"internal_sponsors": {
+				Description: "The object IDs of users or groups in this tenant who sponsor the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"external_sponsors": {
+				Description: "The object IDs of guest users or groups who sponsor the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func connectedOrganizationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	properties := stable.ConnectedOrganization{
+		Description:     nullable.NoZero(d.Get("description").(string)),
+		DisplayName:     nullable.Value(d.Get("display_name").(string)),
+		IdentitySources: expandConnectedOrganizationIdentitySources(d.Get("identity_source").([]interface{})),
+		State:           pointer.To(stable.ConnectedOrganizationState(d.Get("state").(string))),
+	}
+
+	resp, err := client.CreateEntitlementManagementConnectedOrganization(ctx, properties, entitlementmanagementconnectedorganization.DefaultCreateEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating connected organization %q", d.Get("display_name").(string))
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating connected organization")
+	}
+	if resp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("ID was nil"), "Creating connected organization")
+	}
+
+	id := stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(*resp.Model.Id)
+	d.SetId(id.ConnectedOrganizationId)
+
+	// Wait for the connected organization to be consistently readable before adding sponsors
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	if diags := connectedOrganizationUpdateSponsors(ctx, d, meta, id); diags != nil {
+		return diags
+	}
+
+	return connectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func connectedOrganizationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	id := stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	tf.LockByName(connectedOrganizationResourceName, id.ID())
+	defer tf.UnlockByName(connectedOrganizationResourceName, id.ID())
+
+	if d.HasChanges("description", "display_name", "state") {
+		properties := stable.ConnectedOrganization{
+			Description: nullable.Value(d.Get("description").(string)),
+			DisplayName: nullable.Value(d.Get("display_name").(string)),
+			State:       pointer.To(stable.ConnectedOrganizationState(d.Get("state").(string))),
+		}
+
+		if _, err := client.UpdateEntitlementManagementConnectedOrganization(ctx, id, properties, entitlementmanagementconnectedorganization.DefaultUpdateEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Updating %s", id)
+		}
+	}
+
+	if diags := connectedOrganizationUpdateSponsors(ctx, d, meta, id); diags != nil {
+		return diags
+	}
+
+	return connectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func connectedOrganizationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+	internalSponsorClient := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationInternalSponsorClient
+	externalSponsorClient := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationExternalSponsorClient
+
+	id := stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	connectedOrganization := resp.Model
+	if connectedOrganization == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	internalSponsorsResp, err := internalSponsorClient.ListEntitlementManagementConnectedOrganizationInternalSponsors(ctx, id, entitlementmanagementconnectedorganizationinternalsponsor.DefaultListEntitlementManagementConnectedOrganizationInternalSponsorsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving internal sponsors for %s", id)
+	}
+
+	internalSponsors := make([]string, 0)
+	for _, sponsor := range pointer.From(internalSponsorsResp.Model) {
+		internalSponsors = append(internalSponsors, pointer.From(sponsor.DirectoryObject().Id))
+	}
+
+	externalSponsorsResp, err := externalSponsorClient.ListEntitlementManagementConnectedOrganizationExternalSponsors(ctx, id, entitlementmanagementconnectedorganizationexternalsponsor.DefaultListEntitlementManagementConnectedOrganizationExternalSponsorsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving external sponsors for %s", id)
+	}
+
+	externalSponsors := make([]string, 0)
+	for _, sponsor := range pointer.From(externalSponsorsResp.Model) {
+		externalSponsors = append(externalSponsors, pointer.From(sponsor.DirectoryObject().Id))
+	}
+
+	tf.Set(d, "description", connectedOrganization.Description.GetOrZero())
+	tf.Set(d, "display_name", connectedOrganization.DisplayName.GetOrZero())
+	tf.Set(d, "external_sponsors", externalSponsors)
+	tf.Set(d, "identity_source", flattenConnectedOrganizationIdentitySources(connectedOrganization.IdentitySources, d.Get("identity_source.0.domain_name").(string)))
+	tf.Set(d, "internal_sponsors", internalSponsors)
+	tf.Set(d, "state", string(pointer.From(connectedOrganization.State)))
+
+	return nil
+}
+
+func connectedOrganizationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	id := stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	if _, err := client.DeleteEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultDeleteEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	// Wait for object to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+// connectedOrganizationUpdateSponsors reconciles the internal and external sponsors of a connected organization with
+// the configuration, adding and removing references as required.
+func connectedOrganizationUpdateSponsors(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.IdentityGovernanceEntitlementManagementConnectedOrganizationId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+	internalSponsorClient := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationInternalSponsorClient
+	externalSponsorClient := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationExternalSponsorClient
+
+	if d.HasChange("internal_sponsors") {
+		sponsorsResp, err := internalSponsorClient.ListEntitlementManagementConnectedOrganizationInternalSponsors(ctx, id, entitlementmanagementconnectedorganizationinternalsponsor.DefaultListEntitlementManagementConnectedOrganizationInternalSponsorsOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve internal sponsors for %s", id)
+		}
+
+		existingSponsors := make([]string, 0)
+		for _, sponsor := range pointer.From(sponsorsResp.Model) {
+			existingSponsors = append(existingSponsors, pointer.From(sponsor.DirectoryObject().Id))
+		}
+		desiredSponsors := *tf.ExpandStringSlicePtr(d.Get("internal_sponsors").(*pluginsdk.Set).List())
+
+		for _, v := range tf.Difference(existingSponsors, desiredSponsors) {
+			if _, err = internalSponsorClient.RemoveEntitlementManagementConnectedOrganizationInternalSponsorRef(ctx, stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationIdInternalSponsorID(id.ConnectedOrganizationId, v), entitlementmanagementconnectedorganizationinternalsponsor.DefaultRemoveEntitlementManagementConnectedOrganizationInternalSponsorRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Could not remove internal sponsor %q from %s", v, id)
+			}
+		}
+
+		for _, v := range tf.Difference(desiredSponsors, existingSponsors) {
+			properties := stable.ReferenceCreate{
+				ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(v).ID()),
+			}
+
+			if _, err = internalSponsorClient.AddEntitlementManagementConnectedOrganizationInternalSponsorRef(ctx, id, properties, entitlementmanagementconnectedorganizationinternalsponsor.DefaultAddEntitlementManagementConnectedOrganizationInternalSponsorRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Could not add internal sponsor %q to %s", v, id)
+			}
+		}
+	}
+
+	if d.HasChange("external_sponsors") {
+		sponsorsResp, err := externalSponsorClient.ListEntitlementManagementConnectedOrganizationExternalSponsors(ctx, id, entitlementmanagementconnectedorganizationexternalsponsor.DefaultListEntitlementManagementConnectedOrganizationExternalSponsorsOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve external sponsors for %s", id)
+		}
+
+		existingSponsors := make([]string, 0)
+		for _, sponsor := range pointer.From(sponsorsResp.Model) {
+			existingSponsors = append(existingSponsors, pointer.From(sponsor.DirectoryObject().Id))
+		}
+		desiredSponsors := *tf.ExpandStringSlicePtr(d.Get("external_sponsors").(*pluginsdk.Set).List())
+
+		for _, v := range tf.Difference(existingSponsors, desiredSponsors) {
+			if _, err = externalSponsorClient.RemoveEntitlementManagementConnectedOrganizationExternalSponsorRef(ctx, stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationIdExternalSponsorID(id.ConnectedOrganizationId, v), entitlementmanagementconnectedorganizationexternalsponsor.DefaultRemoveEntitlementManagementConnectedOrganizationExternalSponsorRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Could not remove external sponsor %q from %s", v, id)
+			}
+		}
+
+		for _, v := range tf.Difference(desiredSponsors, existingSponsors) {
+			properties := stable.ReferenceCreate{
+				ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(v).ID()),
+			}
+
+			if _, err = externalSponsorClient.AddEntitlementManagementConnectedOrganizationExternalSponsorRef(ctx, id, properties, entitlementmanagementconnectedorganizationexternalsponsor.DefaultAddEntitlementManagementConnectedOrganizationExternalSponsorRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Could not add external sponsor %q to %s", v, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandConnectedOrganizationIdentitySources(input []interface{}) *[]stable.IdentitySource {
+	result := make([]stable.IdentitySource, 0)
+
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tenantId := v["tenant_id"].(string); tenantId != "" {
+			result = append(result, stable.AzureActiveDirectoryTenant{
+				DisplayName: nullable.NoZero(v["display_name"].(string)),
+				TenantId:    nullable.Value(tenantId),
+			})
+		} else {
+			result = append(result, stable.DomainIdentitySource{
+				DisplayName: nullable.NoZero(v["display_name"].(string)),
+				DomainName:  nullable.Value(v["domain_name"].(string)),
+			})
+		}
+	}
+
+	return &result
+}
+
+// flattenConnectedOrganizationIdentitySources flattens the identity source of a connected organization. When a
+// domain belongs to an Azure Active Directory tenant, the service resolves it to that tenant, so the configured domain
+// name is retained in order to avoid a diff.
+func flattenConnectedOrganizationIdentitySources(input *[]stable.IdentitySource, domainName string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, source := range *input {
+		switch v := source.(type) {
+		case stable.AzureActiveDirectoryTenant:
+			result = append(result, map[string]interface{}{
+				"display_name": v.DisplayName.GetOrZero(),
+				"domain_name":  domainName,
+				"tenant_id":    v.TenantId.GetOrZero(),
+			})
+		case stable.DomainIdentitySource:
+			result = append(result, map[string]interface{}{
+				"display_name": v.DisplayName.GetOrZero(),
+				"domain_name":  v.DomainName.GetOrZero(),
+				"tenant_id":    "",
+			})
+		case stable.ExternalDomainFederation:
+			result = append(result, map[string]interface{}{
+				"display_name": v.DisplayName.GetOrZero(),
+				"domain_name":  v.DomainName.GetOrZero(),
+				"tenant_id":    "",
+			})
+		}
+	}
+
+	return result
+}
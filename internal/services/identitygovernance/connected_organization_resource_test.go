@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ConnectedOrganizationResource struct{}
+
+func TestAccConnectedOrganization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("configured"),
+			),
+		},
+		data.ImportStep("identity_source.0.domain_name"),
+	})
+}
+
+func TestAccConnectedOrganization_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_sponsors.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("identity_source.0.domain_name"),
+	})
+}
+
+func TestAccConnectedOrganization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("identity_source.0.domain_name"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_sponsors.#").HasValue("2"),
+				check.That(data.ResourceName).Key("state").HasValue("proposed"),
+			),
+		},
+		data.ImportStep("identity_source.0.domain_name"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_sponsors.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("identity_source.0.domain_name"),
+	})
+}
+
+func (ConnectedOrganizationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.ConnectedOrganizationClient
+	id := stable.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(state.ID)
+
+	resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ConnectedOrganizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-connected-organization-%[1]d"
+
+  identity_source {
+    domain_name = "acctest-%[1]d.example.com"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ConnectedOrganizationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-connected-organization-updated-%[1]d"
+  description  = "Test connected organization %[1]d"
+  state        = "proposed"
+
+  identity_source {
+    domain_name = "acctest-%[1]d.example.com"
+  }
+
+  internal_sponsors = [
+    azuread_user.test.object_id,
+    azuread_group.test.object_id,
+  ]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
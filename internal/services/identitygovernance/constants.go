@@ -19,6 +19,30 @@ var possibleValuesForAccessReviewRecurrenceType = []string{
 	AccessReviewRecurrenceTypeWeekly,
 }
 
+const (
+	AccessReviewApplyActionDisableAndDeleteUser = "disableAndDeleteUser"
+	AccessReviewApplyActionRemoveAccess         = "removeAccess"
+)
+
+var possibleValuesForAccessReviewApplyAction = []string{
+	AccessReviewApplyActionDisableAndDeleteUser,
+	AccessReviewApplyActionRemoveAccess,
+}
+
+const (
+	AccessReviewDefaultDecisionApprove        = "Approve"
+	AccessReviewDefaultDecisionDeny           = "Deny"
+	AccessReviewDefaultDecisionRecommendation = "Recommendation"
+)
+
+var possibleValuesForAccessReviewDefaultDecision = []string{
+	AccessReviewDefaultDecisionApprove,
+	AccessReviewDefaultDecisionDeny,
+	AccessReviewDefaultDecisionRecommendation,
+}
+
+const AccessReviewQueryTypeMicrosoftGraph = "MicrosoftGraph"
+
 const (
 	AccessReviewReviewerTypeManager   = "Manager"
 	AccessReviewReviewerTypeReviewers = "Reviewers"
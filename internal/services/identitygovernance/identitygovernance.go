@@ -10,7 +10,9 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalogaccesspackageresourcerole"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"golang.org/x/text/cases"
@@ -47,6 +49,26 @@ func GetAccessPackageResourcesRoleScope(ctx context.Context, client *entitlement
 	return nil, nil
 }
 
+// GetAccessPackageResourceRoles returns the roles which are available for the specified resource in an access package
+// catalog, such as the member and owner roles of a group, or the app roles of an application.
+func GetAccessPackageResourceRoles(ctx context.Context, client *entitlementmanagementaccesspackagecatalogaccesspackageresourcerole.EntitlementManagementAccessPackageCatalogAccessPackageResourceRoleClient, catalogId beta.IdentityGovernanceEntitlementManagementAccessPackageCatalogId, resource beta.AccessPackageResource) (*[]beta.AccessPackageResourceRole, error) {
+	options := entitlementmanagementaccesspackagecatalogaccesspackageresourcerole.ListEntitlementManagementAccessPackageCatalogResourceRolesOperationOptions{
+		Expand: &odata.Expand{Relationship: "accessPackageResource"},
+		Filter: pointer.To(fmt.Sprintf("originSystem eq '%s' and accessPackageResource/id eq '%s'", resource.OriginSystem.GetOrZero(), pointer.From(resource.Id))),
+	}
+
+	resp, err := client.ListEntitlementManagementAccessPackageCatalogResourceRoles(ctx, catalogId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing resource roles for %s: %v", catalogId, err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("listing resource roles for %s: model was nil", catalogId)
+	}
+
+	return resp.Model, nil
+}
+
 func expandRequestorSettings(input []interface{}) (*beta.RequestorSettings, error) {
 	if len(input) == 0 {
 		return nil, nil
@@ -431,6 +453,92 @@ func flattenAccessPackageLocalizedContent(input *beta.AccessPackageLocalizedCont
 	return result
 }
 
+func expandCustomExtensionStageSettings(input []interface{}) *[]beta.CustomExtensionStageSetting {
+	result := make([]beta.CustomExtensionStageSetting, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		var customExtension beta.CustomCalloutExtension = beta.BaseCustomCalloutExtensionImpl{
+			Id:                     pointer.To(v["custom_extension_id"].(string)),
+			OmitDiscriminatedValue: true,
+		}
+
+		result = append(result, beta.CustomExtensionStageSetting{
+			CustomExtension: &customExtension,
+			Stage:           pointer.To(beta.AccessPackageCustomExtensionStage(v["stage"].(string))),
+		})
+	}
+
+	return &result
+}
+
+func flattenCustomExtensionStageSettings(input *[]beta.CustomExtensionStageSetting) []map[string]interface{} {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, setting := range *input {
+		customExtensionId := ""
+		if setting.CustomExtension != nil && *setting.CustomExtension != nil {
+			customExtensionId = pointer.From((*setting.CustomExtension).CustomCalloutExtension().Id)
+		}
+
+		result = append(result, map[string]interface{}{
+			"custom_extension_id": customExtensionId,
+			"stage":               string(pointer.From(setting.Stage)),
+		})
+	}
+
+	return result
+}
+
+func expandAutomaticRequestSettings(input []interface{}, policy *stable.AccessPackageAssignmentPolicy) {
+	if len(input) == 0 {
+		return
+	}
+
+	in := input[0].(map[string]interface{})
+
+	policy.AllowedTargetScope = pointer.To(stable.AllowedTargetScope_SpecificDirectoryUsers)
+	policy.SpecificAllowedTargets = &[]stable.SubjectSet{
+		stable.AttributeRuleMembers{
+			Description:    nullable.NoZero(in["membership_rule_description"].(string)),
+			MembershipRule: nullable.Value(in["membership_rule"].(string)),
+		},
+	}
+	policy.AutomaticRequestSettings = &stable.AccessPackageAutomaticRequestSettings{
+		GracePeriodBeforeAccessRemoval:             nullable.NoZero(in["grace_period_before_access_removal"].(string)),
+		RemoveAccessWhenTargetLeavesAllowedTargets: nullable.Value(in["remove_access_when_target_leaves"].(bool)),
+		RequestAccessForAllowedTargets:             nullable.Value(true),
+	}
+}
+
+func flattenAutomaticRequestSettings(policy *stable.AccessPackageAssignmentPolicy) []map[string]interface{} {
+	if policy == nil || policy.AutomaticRequestSettings == nil || !policy.AutomaticRequestSettings.RequestAccessForAllowedTargets.GetOrZero() {
+		return nil
+	}
+
+	var rule *stable.AttributeRuleMembers
+	for _, target := range pointer.From(policy.SpecificAllowedTargets) {
+		if v, ok := target.(stable.AttributeRuleMembers); ok {
+			rule = &v
+			break
+		}
+	}
+	if rule == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"grace_period_before_access_removal": policy.AutomaticRequestSettings.GracePeriodBeforeAccessRemoval.GetOrZero(),
+		"membership_rule":                    rule.MembershipRule.GetOrZero(),
+		"membership_rule_description":        rule.Description.GetOrZero(),
+		"remove_access_when_target_leaves":   policy.AutomaticRequestSettings.RemoveAccessWhenTargetLeavesAllowedTargets.GetOrZero(),
+	}}
+}
+
 func formatODataType(in string) string {
 	return cases.Title(language.AmericanEnglish, cases.NoLower).String(strings.TrimPrefix(in, "#microsoft.graph."))
 }
@@ -0,0 +1,539 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowtaskdefinition"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowworkflow"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+const lifecycleWorkflowResourceName = "azuread_lifecycle_workflow"
+
+func lifecycleWorkflowResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: lifecycleWorkflowResourceCreate,
+		ReadContext:   lifecycleWorkflowResourceRead,
+		UpdateContext: lifecycleWorkflowResourceUpdate,
+		DeleteContext: lifecycleWorkflowResourceDelete,
+
+		CustomizeDiff: lifecycleWorkflowResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the workflow",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description:  "The description of the workflow",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"category": {
+				Description:  "The category of the workflow",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForIdentityGovernanceLifecycleWorkflowCategory(), false),
+			},
+
+			"enabled": {
+				Description: "Whether the workflow is enabled, so that it can run on demand or on schedule",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"scheduling_enabled": {
+				Description: "Whether the workflow runs on the schedule defined in the tenant's lifecycle workflow settings",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"execution_conditions": {
+				Description: "When and for whom the workflow runs. When omitted, the workflow can only be run on demand",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"scope_rule": {
+							Description:  "The filter rule specifying the users for whom the workflow runs, e.g. `(department eq 'Sales')`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"trigger": {
+							Description: "The time-based user attribute which triggers the workflow",
+							Type:        pluginsdk.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_based_attribute": {
+										Description:  "The user attribute on which the workflow is triggered",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForIdentityGovernanceWorkflowTriggerTimeBasedAttribute(), false),
+									},
+
+									"offset_in_days": {
+										Description:  "The number of days before (negative) or after (positive) the attribute's date on which the workflow is triggered",
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(-180, 180),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"task": {
+				Description: "The tasks run by the workflow, in order of execution",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"task_definition_id": {
+							Description:  "The ID of the built-in task definition for this task",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"display_name": {
+							Description:  "The display name of the task",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Description:  "The description of the task",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Description: "Whether the task runs",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"continue_on_error": {
+							Description: "Whether the workflow continues to run subsequent tasks if this task fails",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"arguments": {
+							Description: "The arguments for the task, as declared by the task definition",
+							Type:        pluginsdk.TypeMap,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"id": {
+							Description: "The ID of the task",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"version": {
+				Description: "The current version number of the workflow",
+				Type:        pluginsdk.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func lifecycleWorkflowResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowTaskDefinitionClient
+
+	if diff.Get("scheduling_enabled").(bool) && !diff.Get("enabled").(bool) {
+		return fmt.Errorf("`scheduling_enabled` cannot be true when `enabled` is false")
+	}
+
+	if !diff.NewValueKnown("task") || !diff.NewValueKnown("category") {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	resp, err := client.ListLifecycleWorkflowTaskDefinitions(ctx, lifecycleworkflowtaskdefinition.DefaultListLifecycleWorkflowTaskDefinitionsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving lifecycle workflow task definitions: %v", err)
+	}
+
+	definitions := make(map[string]stable.IdentityGovernanceTaskDefinition)
+	for _, definition := range pointer.From(resp.Model) {
+		definitions[strings.ToLower(pointer.From(definition.Id))] = definition
+	}
+
+	category := diff.Get("category").(string)
+
+	for i, raw := range diff.Get("task").([]interface{}) {
+		task, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		taskDefinitionId := task["task_definition_id"].(string)
+		if taskDefinitionId == "" {
+			continue
+		}
+
+		definition, ok := definitions[strings.ToLower(taskDefinitionId)]
+		if !ok {
+			return fmt.Errorf("`task.%d.task_definition_id`: no lifecycle workflow task definition was found with ID %q", i, taskDefinitionId)
+		}
+
+		if definitionCategories := strings.Split(string(pointer.From(definition.Category)), ","); category != "" && !slices.Contains(definitionCategories, category) {
+			return fmt.Errorf("`task.%d`: task definition %q cannot be used in a %q workflow, supported categories are: %s", i, pointer.From(definition.DisplayName), category, strings.Join(definitionCategories, ", "))
+		}
+
+		parameters := make(map[string]stable.IdentityGovernanceParameter)
+		for _, parameter := range pointer.From(definition.Parameters) {
+			parameters[pointer.From(parameter.Name)] = parameter
+		}
+
+		for name, value := range task["arguments"].(map[string]interface{}) {
+			parameter, ok := parameters[name]
+			if !ok {
+				validNames := make([]string, 0, len(parameters))
+				for n := range parameters {
+					validNames = append(validNames, n)
+				}
+				sort.Strings(validNames)
+				return fmt.Errorf("`task.%d.arguments`: argument %q is not declared by task definition %q, valid arguments are: %s", i, name, pointer.From(definition.DisplayName), strings.Join(validNames, ", "))
+			}
+
+			// Values that are not yet known are validated by the API when the workflow is created
+			if value.(string) == "" {
+				continue
+			}
+
+			if allowedValues := pointer.From(parameter.Values); len(allowedValues) > 0 && !slices.Contains(allowedValues, value.(string)) {
+				return fmt.Errorf("`task.%d.arguments`: invalid value %q for argument %q, possible values are: %s", i, value.(string), name, strings.Join(allowedValues, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func lifecycleWorkflowResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowClient
+
+	properties := expandLifecycleWorkflow(d)
+
+	resp, err := client.CreateLifecycleWorkflowWorkflow(ctx, properties, lifecycleworkflowworkflow.DefaultCreateLifecycleWorkflowWorkflowOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating lifecycle workflow")
+	}
+
+	workflow := resp.Model
+	if workflow == nil || workflow.Id == nil {
+		return tf.ErrorDiagF(errors.New("model or ID was nil"), "Creating lifecycle workflow")
+	}
+
+	id := stable.NewIdentityGovernanceLifecycleWorkflowWorkflowID(*workflow.Id)
+	d.SetId(id.WorkflowId)
+
+	// Wait for the workflow to be consistently readable
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetLifecycleWorkflowWorkflow(ctx, id, lifecycleworkflowworkflow.DefaultGetLifecycleWorkflowWorkflowOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return lifecycleWorkflowResourceRead(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowClient
+
+	id := stable.NewIdentityGovernanceLifecycleWorkflowWorkflowID(d.Id())
+
+	tf.LockByName(lifecycleWorkflowResourceName, id.WorkflowId)
+	defer tf.UnlockByName(lifecycleWorkflowResourceName, id.WorkflowId)
+
+	properties := expandLifecycleWorkflow(d)
+
+	// Tasks and execution conditions can only be changed by creating a new version of the workflow, whereas the
+	// remaining properties are updated in place
+	if d.HasChanges("execution_conditions", "task") {
+		request := lifecycleworkflowworkflow.CreateLifecycleWorkflowIdentityGovernanceCreateNewVersionRequest{
+			Workflow: &properties,
+		}
+		if _, err := client.CreateLifecycleWorkflowIdentityGovernanceCreateNewVersion(ctx, id, request, lifecycleworkflowworkflow.DefaultCreateLifecycleWorkflowIdentityGovernanceCreateNewVersionOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Creating new version of %s", id)
+		}
+
+		return lifecycleWorkflowResourceRead(ctx, d, meta)
+	}
+
+	workflow := stable.IdentityGovernanceWorkflow{
+		Description:         properties.Description,
+		DisplayName:         properties.DisplayName,
+		IsEnabled:           properties.IsEnabled,
+		IsSchedulingEnabled: properties.IsSchedulingEnabled,
+	}
+
+	if _, err := client.UpdateLifecycleWorkflowWorkflow(ctx, id, workflow, lifecycleworkflowworkflow.DefaultUpdateLifecycleWorkflowWorkflowOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return lifecycleWorkflowResourceRead(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowClient
+
+	id := stable.NewIdentityGovernanceLifecycleWorkflowWorkflowID(d.Id())
+
+	options := lifecycleworkflowworkflow.GetLifecycleWorkflowWorkflowOperationOptions{
+		Expand: &odata.Expand{Relationship: "tasks"},
+	}
+
+	resp, err := client.GetLifecycleWorkflowWorkflow(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	workflow := resp.Model
+	if workflow == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "category", string(pointer.From(workflow.Category)))
+	tf.Set(d, "description", workflow.Description.GetOrZero())
+	tf.Set(d, "display_name", pointer.From(workflow.DisplayName))
+	tf.Set(d, "enabled", pointer.From(workflow.IsEnabled))
+	tf.Set(d, "execution_conditions", flattenLifecycleWorkflowExecutionConditions(workflow.ExecutionConditions))
+	tf.Set(d, "scheduling_enabled", pointer.From(workflow.IsSchedulingEnabled))
+	tf.Set(d, "task", flattenLifecycleWorkflowTasks(workflow.Tasks))
+	tf.Set(d, "version", workflow.Version.GetOrZero())
+
+	return nil
+}
+
+func lifecycleWorkflowResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowClient
+
+	id := stable.NewIdentityGovernanceLifecycleWorkflowWorkflowID(d.Id())
+
+	if _, err := client.DeleteLifecycleWorkflowWorkflow(ctx, id, lifecycleworkflowworkflow.DefaultDeleteLifecycleWorkflowWorkflowOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	// Wait for object to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetLifecycleWorkflowWorkflow(ctx, id, lifecycleworkflowworkflow.DefaultGetLifecycleWorkflowWorkflowOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandLifecycleWorkflow(d *pluginsdk.ResourceData) stable.IdentityGovernanceWorkflow {
+	return stable.IdentityGovernanceWorkflow{
+		Category:            pointer.To(stable.IdentityGovernanceLifecycleWorkflowCategory(d.Get("category").(string))),
+		Description:         nullable.NoZero(d.Get("description").(string)),
+		DisplayName:         pointer.To(d.Get("display_name").(string)),
+		ExecutionConditions: expandLifecycleWorkflowExecutionConditions(d.Get("execution_conditions").([]interface{})),
+		IsEnabled:           pointer.To(d.Get("enabled").(bool)),
+		IsSchedulingEnabled: pointer.To(d.Get("scheduling_enabled").(bool)),
+		Tasks:               expandLifecycleWorkflowTasks(d.Get("task").([]interface{})),
+	}
+}
+
+func expandLifecycleWorkflowExecutionConditions(input []interface{}) stable.IdentityGovernanceWorkflowExecutionConditions {
+	if len(input) == 0 || input[0] == nil {
+		return stable.IdentityGovernanceOnDemandExecutionOnly{}
+	}
+	in := input[0].(map[string]interface{})
+
+	result := stable.IdentityGovernanceTriggerAndScopeBasedConditions{
+		Scope: stable.IdentityGovernanceRuleBasedSubjectSet{
+			Rule: pointer.To(in["scope_rule"].(string)),
+		},
+	}
+
+	if triggers := in["trigger"].([]interface{}); len(triggers) > 0 && triggers[0] != nil {
+		trigger := triggers[0].(map[string]interface{})
+		result.Trigger = stable.IdentityGovernanceTimeBasedAttributeTrigger{
+			OffsetInDays:       pointer.To(int64(trigger["offset_in_days"].(int))),
+			TimeBasedAttribute: pointer.To(stable.IdentityGovernanceWorkflowTriggerTimeBasedAttribute(trigger["time_based_attribute"].(string))),
+		}
+	}
+
+	return result
+}
+
+func flattenLifecycleWorkflowExecutionConditions(input stable.IdentityGovernanceWorkflowExecutionConditions) []map[string]interface{} {
+	conditions, ok := input.(stable.IdentityGovernanceTriggerAndScopeBasedConditions)
+	if !ok {
+		return []map[string]interface{}{}
+	}
+
+	scopeRule := ""
+	if scope, ok := conditions.Scope.(stable.IdentityGovernanceRuleBasedSubjectSet); ok {
+		scopeRule = pointer.From(scope.Rule)
+	}
+
+	triggers := make([]map[string]interface{}, 0)
+	if trigger, ok := conditions.Trigger.(stable.IdentityGovernanceTimeBasedAttributeTrigger); ok {
+		triggers = append(triggers, map[string]interface{}{
+			"offset_in_days":       pointer.From(trigger.OffsetInDays),
+			"time_based_attribute": string(pointer.From(trigger.TimeBasedAttribute)),
+		})
+	}
+
+	return []map[string]interface{}{{
+		"scope_rule": scopeRule,
+		"trigger":    triggers,
+	}}
+}
+
+func expandLifecycleWorkflowTasks(input []interface{}) *[]stable.IdentityGovernanceTask {
+	result := make([]stable.IdentityGovernanceTask, 0)
+
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		arguments := make([]stable.KeyValuePair, 0)
+		for name, value := range v["arguments"].(map[string]interface{}) {
+			arguments = append(arguments, stable.KeyValuePair{
+				Name:  pointer.To(name),
+				Value: nullable.Value(value.(string)),
+			})
+		}
+
+		// Sort arguments so that requests are deterministic
+		sort.Slice(arguments, func(i, j int) bool {
+			return pointer.From(arguments[i].Name) < pointer.From(arguments[j].Name)
+		})
+
+		result = append(result, stable.IdentityGovernanceTask{
+			Arguments:        arguments,
+			ContinueOnError:  pointer.To(v["continue_on_error"].(bool)),
+			Description:      nullable.NoZero(v["description"].(string)),
+			DisplayName:      v["display_name"].(string),
+			IsEnabled:        pointer.To(v["enabled"].(bool)),
+			TaskDefinitionId: v["task_definition_id"].(string),
+		})
+	}
+
+	return &result
+}
+
+func flattenLifecycleWorkflowTasks(input *[]stable.IdentityGovernanceTask) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	// Tasks are returned in no particular order, so sort them by their execution sequence
+	tasks := slices.Clone(*input)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return pointer.From(tasks[i].ExecutionSequence) < pointer.From(tasks[j].ExecutionSequence)
+	})
+
+	for _, task := range tasks {
+		arguments := make(map[string]interface{})
+		for _, argument := range task.Arguments {
+			arguments[pointer.From(argument.Name)] = argument.Value.GetOrZero()
+		}
+
+		result = append(result, map[string]interface{}{
+			"arguments":          arguments,
+			"continue_on_error":  pointer.From(task.ContinueOnError),
+			"description":        task.Description.GetOrZero(),
+			"display_name":       task.DisplayName,
+			"enabled":            pointer.From(task.IsEnabled),
+			"id":                 pointer.From(task.Id),
+			"task_definition_id": task.TaskDefinitionId,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowworkflow"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type LifecycleWorkflowResource struct{}
+
+func TestAccLifecycleWorkflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("task.#").HasValue("1"),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLifecycleWorkflow_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("task.#").HasValue("2"),
+				check.That(data.ResourceName).Key("task.1.arguments.groupID").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLifecycleWorkflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLifecycleWorkflow_onDemand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.onDemand(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("execution_conditions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LifecycleWorkflowResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.LifecycleWorkflowClient
+	id := stable.NewIdentityGovernanceLifecycleWorkflowWorkflowID(state.ID)
+
+	if resp, err := client.GetLifecycleWorkflowWorkflow(ctx, id, lifecycleworkflowworkflow.DefaultGetLifecycleWorkflowWorkflowOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (LifecycleWorkflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_lifecycle_workflow" "test" {
+  display_name = "acctest-LifecycleWorkflow-%[1]d"
+  category     = "joiner"
+
+  execution_conditions {
+    scope_rule = "(department eq 'acctest-%[1]d')"
+
+    trigger {
+      time_based_attribute = "employeeHireDate"
+      offset_in_days       = 0
+    }
+  }
+
+  task {
+    display_name       = "Enable user account"
+    task_definition_id = "6fc52c9d-398b-4305-9763-15f42c1676fc"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (LifecycleWorkflowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_lifecycle_workflow" "test" {
+  display_name       = "acctest-LifecycleWorkflow-%[1]d-updated"
+  description        = "Onboarding for new starters"
+  category           = "joiner"
+  enabled            = true
+  scheduling_enabled = false
+
+  execution_conditions {
+    scope_rule = "(department eq 'acctest-%[1]d')"
+
+    trigger {
+      time_based_attribute = "employeeHireDate"
+      offset_in_days       = -2
+    }
+  }
+
+  task {
+    display_name       = "Enable user account"
+    description        = "Enable the account ahead of the first day"
+    task_definition_id = "6fc52c9d-398b-4305-9763-15f42c1676fc"
+    continue_on_error  = true
+  }
+
+  task {
+    display_name       = "Add user to groups"
+    task_definition_id = "22085229-5809-45e8-97fd-270d28d66910"
+
+    arguments = {
+      groupID = azuread_group.test.object_id
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (LifecycleWorkflowResource) onDemand(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_lifecycle_workflow" "test" {
+  display_name = "acctest-LifecycleWorkflow-%[1]d"
+  category     = "leaver"
+
+  task {
+    display_name       = "Disable user account"
+    task_definition_id = "1dfdfcc7-52fa-4c2e-bf3a-e3919cc12950"
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/lifecycleworkflowtaskdefinition"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func lifecycleWorkflowTaskDefinitionsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: lifecycleWorkflowTaskDefinitionsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"category": {
+				Description:  "Only return task definitions which can be used in workflows of this category",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForIdentityGovernanceLifecycleTaskCategory(), false),
+			},
+
+			"task_definitions": {
+				Description: "The built-in lifecycle workflow task definitions",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the task definition",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the task definition",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "The description of the task definition",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"categories": {
+							Description: "The workflow categories in which the task definition can be used",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"continue_on_error": {
+							Description: "Whether workflows continue to run by default when a task using this definition fails",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"version": {
+							Description: "The version of the task definition",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"parameter": {
+							Description: "The arguments which can be supplied to tasks using this definition",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Description: "The name of the argument",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"value_type": {
+										Description: "The type of value accepted by the argument",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"values": {
+										Description: "The values accepted by the argument, if restricted",
+										Type:        pluginsdk.TypeList,
+										Computed:    true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func lifecycleWorkflowTaskDefinitionsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowTaskDefinitionClient
+
+	resp, err := client.ListLifecycleWorkflowTaskDefinitions(ctx, lifecycleworkflowtaskdefinition.DefaultListLifecycleWorkflowTaskDefinitionsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing lifecycle workflow task definitions")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Bad API Response")
+	}
+
+	category := d.Get("category").(string)
+
+	definitionIds := make([]string, 0)
+	definitions := make([]map[string]interface{}, 0)
+	for _, definition := range *resp.Model {
+		// The category of a task definition is a comma-separated list of the workflow categories it supports
+		categories := strings.Split(string(pointer.From(definition.Category)), ",")
+		if category != "" && !slices.Contains(categories, category) {
+			continue
+		}
+
+		parameters := make([]map[string]interface{}, 0)
+		for _, parameter := range pointer.From(definition.Parameters) {
+			parameters = append(parameters, map[string]interface{}{
+				"name":       pointer.From(parameter.Name),
+				"value_type": string(pointer.From(parameter.ValueType)),
+				"values":     pointer.From(parameter.Values),
+			})
+		}
+
+		definitionId := pointer.From(definition.Id)
+		definitionIds = append(definitionIds, definitionId)
+
+		definitions = append(definitions, map[string]interface{}{
+			"categories":        categories,
+			"continue_on_error": pointer.From(definition.ContinueOnError),
+			"description":       definition.Description.GetOrZero(),
+			"display_name":      pointer.From(definition.DisplayName),
+			"id":                definitionId,
+			"parameter":         parameters,
+			"version":           pointer.From(definition.Version),
+		})
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(category + "/" + strings.Join(definitionIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for task definition IDs")
+	}
+
+	d.SetId("lifecycleWorkflowTaskDefinitions#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "task_definitions", definitions)
+
+	return nil
+}
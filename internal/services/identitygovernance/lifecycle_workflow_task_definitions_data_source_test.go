@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type LifecycleWorkflowTaskDefinitionsDataSource struct{}
+
+func TestAccLifecycleWorkflowTaskDefinitionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_lifecycle_workflow_task_definitions", "test")
+	r := LifecycleWorkflowTaskDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("task_definitions.#").Exists(),
+				check.That(data.ResourceName).Key("task_definitions.0.id").IsUuid(),
+				check.That(data.ResourceName).Key("task_definitions.0.display_name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccLifecycleWorkflowTaskDefinitionsDataSource_category(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_lifecycle_workflow_task_definitions", "test")
+	r := LifecycleWorkflowTaskDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.category(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("task_definitions.#").Exists(),
+				check.That(data.ResourceName).Key("task_definitions.0.id").IsUuid(),
+			),
+		},
+	})
+}
+
+func (LifecycleWorkflowTaskDefinitionsDataSource) basic(_ acceptance.TestData) string {
+	return `provider azuread {}
+data "azuread_lifecycle_workflow_task_definitions" "test" {}`
+}
+
+func (LifecycleWorkflowTaskDefinitionsDataSource) category(_ acceptance.TestData) string {
+	return `provider azuread {}
+data "azuread_lifecycle_workflow_task_definitions" "test" {
+  category = "leaver"
+}`
+}
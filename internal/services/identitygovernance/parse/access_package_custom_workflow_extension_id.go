@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type AccessPackageCustomWorkflowExtensionId struct {
+	CatalogId                 string
+	CustomWorkflowExtensionId string
+}
+
+func (id AccessPackageCustomWorkflowExtensionId) ID() string {
+	return fmt.Sprintf("%s/%s", id.CatalogId, id.CustomWorkflowExtensionId)
+}
+
+func NewAccessPackageCustomWorkflowExtensionID(catalogId, customWorkflowExtensionId string) AccessPackageCustomWorkflowExtensionId {
+	return AccessPackageCustomWorkflowExtensionId{
+		CatalogId:                 catalogId,
+		CustomWorkflowExtensionId: customWorkflowExtensionId,
+	}
+}
+
+func AccessPackageCustomWorkflowExtensionID(idString string) (*AccessPackageCustomWorkflowExtensionId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("ID should be in the format {catalogId}/{customWorkflowExtensionId} - but got %q", idString)
+	}
+
+	for i, p := range parts {
+		if _, err := uuid.ParseUUID(p); err != nil {
+			return nil, fmt.Errorf("specified ID segment #%d (%q) is not a valid UUID: %s", i, p, err)
+		}
+	}
+
+	return &AccessPackageCustomWorkflowExtensionId{
+		CatalogId:                 parts[0],
+		CustomWorkflowExtensionId: parts[1],
+	}, nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type AccessPackageIncompatibleAccessPackageId struct {
+	AccessPackageId             string
+	IncompatibleAccessPackageId string
+}
+
+func (id AccessPackageIncompatibleAccessPackageId) ID() string {
+	return fmt.Sprintf("%s/%s", id.AccessPackageId, id.IncompatibleAccessPackageId)
+}
+
+func NewAccessPackageIncompatibleAccessPackageID(accessPackageId, incompatibleAccessPackageId string) AccessPackageIncompatibleAccessPackageId {
+	return AccessPackageIncompatibleAccessPackageId{
+		AccessPackageId:             accessPackageId,
+		IncompatibleAccessPackageId: incompatibleAccessPackageId,
+	}
+}
+
+func AccessPackageIncompatibleAccessPackageID(idString string) (*AccessPackageIncompatibleAccessPackageId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("ID should be in the format {accessPackageId}/{incompatibleAccessPackageId} - but got %q", idString)
+	}
+
+	for i, p := range parts {
+		if _, err := uuid.ParseUUID(p); err != nil {
+			return nil, fmt.Errorf("specified ID segment #%d (%q) is not a valid UUID: %s", i, p, err)
+		}
+	}
+
+	return &AccessPackageIncompatibleAccessPackageId{
+		AccessPackageId:             parts[0],
+		IncompatibleAccessPackageId: parts[1],
+	}, nil
+}
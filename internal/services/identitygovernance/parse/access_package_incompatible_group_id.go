@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type AccessPackageIncompatibleGroupId struct {
+	AccessPackageId string
+	GroupId         string
+}
+
+func (id AccessPackageIncompatibleGroupId) ID() string {
+	return fmt.Sprintf("%s/%s", id.AccessPackageId, id.GroupId)
+}
+
+func NewAccessPackageIncompatibleGroupID(accessPackageId, groupId string) AccessPackageIncompatibleGroupId {
+	return AccessPackageIncompatibleGroupId{
+		AccessPackageId: accessPackageId,
+		GroupId:         groupId,
+	}
+}
+
+func AccessPackageIncompatibleGroupID(idString string) (*AccessPackageIncompatibleGroupId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("ID should be in the format {accessPackageId}/{groupId} - but got %q", idString)
+	}
+
+	for i, p := range parts {
+		if _, err := uuid.ParseUUID(p); err != nil {
+			return nil, fmt.Errorf("specified ID segment #%d (%q) is not a valid UUID: %s", i, p, err)
+		}
+	}
+
+	return &AccessPackageIncompatibleGroupId{
+		AccessPackageId: parts[0],
+		GroupId:         parts[1],
+	}, nil
+}
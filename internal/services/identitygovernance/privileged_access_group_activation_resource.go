@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedule"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type PrivilegedAccessGroupActivationModel struct {
+	AssignmentType string `tfschema:"assignment_type"`
+	Duration       string `tfschema:"duration"`
+	ExpirationDate string `tfschema:"expiration_date"`
+	GroupId        string `tfschema:"group_id"`
+	Justification  string `tfschema:"justification"`
+	PrincipalId    string `tfschema:"principal_id"`
+	StartDate      string `tfschema:"start_date"`
+	Status         string `tfschema:"status"`
+	TicketNumber   string `tfschema:"ticket_number"`
+	TicketSystem   string `tfschema:"ticket_system"`
+}
+
+var _ sdk.Resource = PrivilegedAccessGroupActivationResource{}
+
+type PrivilegedAccessGroupActivationResource struct{}
+
+func (r PrivilegedAccessGroupActivationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsUUID
+}
+
+func (r PrivilegedAccessGroupActivationResource) ResourceType() string {
+	return "azuread_privileged_access_group_activation"
+}
+
+func (r PrivilegedAccessGroupActivationResource) ModelObject() interface{} {
+	return &PrivilegedAccessGroupActivationModel{}
+}
+
+func (r PrivilegedAccessGroupActivationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group_id": {
+			Description:      "The ID of the group for which the eligible assignment is activated",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+		},
+
+		"assignment_type": {
+			Description:      "The type of the eligible assignment to activate",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(stable.PossibleValuesForPrivilegedAccessGroupRelationships(), false)),
+		},
+
+		"principal_id": {
+			Description:      "The ID of the principal activating the eligible assignment. Defaults to the authenticated principal",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+		},
+
+		"duration": {
+			Description:      "How long the activation lasts, formatted as an ISO8601 duration string (e.g. PT2H for 2 hours)",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+		},
+
+		"justification": {
+			Description:      "The justification for the activation",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+		},
+
+		"ticket_number": {
+			Description:      "The ticket number authorising the activation",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			RequiredWith:     []string{"ticket_system"},
+			ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+		},
+
+		"ticket_system": {
+			Description:      "The ticket system authorising the activation",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			RequiredWith:     []string{"ticket_number"},
+			ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func (r PrivilegedAccessGroupActivationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"start_date": {
+			Description: "The date that the activation started",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"expiration_date": {
+			Description: "The date that the activation expires",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"status": {
+			Description: "The status of the activation request",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r PrivilegedAccessGroupActivationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.IdentityGovernance.PrivilegedAccessGroupAssignmentScheduleRequestClient
+
+			var model PrivilegedAccessGroupActivationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.PrincipalId == "" {
+				model.PrincipalId = metadata.Client.ObjectID
+			}
+
+			properties := stable.PrivilegedAccessGroupAssignmentScheduleRequest{
+				AccessId:      stable.PrivilegedAccessGroupRelationships(model.AssignmentType),
+				PrincipalId:   nullable.Value(model.PrincipalId),
+				GroupId:       nullable.Value(model.GroupId),
+				Action:        pointer.To(stable.ScheduleRequestActions_SelfActivate),
+				Justification: nullable.NoZero(model.Justification),
+				ScheduleInfo: &stable.RequestSchedule{
+					Expiration: &stable.ExpirationPattern{
+						Duration: nullable.Value(model.Duration),
+						Type:     pointer.To(stable.ExpirationPatternType_AfterDuration),
+					},
+				},
+			}
+
+			if model.TicketNumber != "" || model.TicketSystem != "" {
+				properties.TicketInfo = &stable.TicketInfo{
+					TicketNumber: nullable.NoZero(model.TicketNumber),
+					TicketSystem: nullable.NoZero(model.TicketSystem),
+				}
+			}
+
+			resp, err := client.CreatePrivilegedAccessGroupAssignmentScheduleRequest(ctx, properties, privilegedaccessgroupassignmentschedulerequest.DefaultCreatePrivilegedAccessGroupAssignmentScheduleRequestOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating activation request for %s assignment of principal %q to group %q: %v", model.AssignmentType, model.PrincipalId, model.GroupId, err)
+			}
+
+			request := resp.Model
+			if request == nil {
+				return fmt.Errorf("creating activation request: model was nil")
+			}
+			if request.Id == nil || *request.Id == "" {
+				return fmt.Errorf("creating activation request: ID returned for request is nil/empty")
+			}
+
+			id := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestID(*request.Id)
+			metadata.ResourceData.SetId(id.PrivilegedAccessGroupAssignmentScheduleRequestId)
+
+			// Activation is asynchronous, so wait for the assignment to be provisioned before returning
+			if _, err = privilegedAccessGroupAssignmentScheduleRequestWait(ctx, client, id, []string{PrivilegedAccessGroupScheduleRequestStatusProvisioned}); err != nil {
+				return fmt.Errorf("waiting for %s to be provisioned: %v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivilegedAccessGroupActivationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			scheduleClient := metadata.Client.IdentityGovernance.PrivilegedAccessGroupAssignmentScheduleClient
+			requestsClient := metadata.Client.IdentityGovernance.PrivilegedAccessGroupAssignmentScheduleRequestClient
+
+			id := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestID(metadata.ResourceData.Id())
+
+			resp, err := requestsClient.GetPrivilegedAccessGroupAssignmentScheduleRequest(ctx, id, privilegedaccessgroupassignmentschedulerequest.DefaultGetPrivilegedAccessGroupAssignmentScheduleRequestOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			request := resp.Model
+			if request == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			status := pointer.From(request.Status)
+			switch status {
+			case PrivilegedAccessGroupScheduleRequestStatusCanceled,
+				PrivilegedAccessGroupScheduleRequestStatusDenied,
+				PrivilegedAccessGroupScheduleRequestStatusFailed,
+				PrivilegedAccessGroupScheduleRequestStatusRevoked:
+				return metadata.MarkAsGone(id)
+			}
+
+			model := PrivilegedAccessGroupActivationModel{
+				AssignmentType: string(request.AccessId),
+				GroupId:        request.GroupId.GetOrZero(),
+				Justification:  request.Justification.GetOrZero(),
+				PrincipalId:    request.PrincipalId.GetOrZero(),
+				Status:         status,
+			}
+
+			if ticketInfo := request.TicketInfo; ticketInfo != nil {
+				model.TicketNumber = ticketInfo.TicketNumber.GetOrZero()
+				model.TicketSystem = ticketInfo.TicketSystem.GetOrZero()
+			}
+
+			if scheduleInfo := request.ScheduleInfo; scheduleInfo != nil {
+				model.StartDate = scheduleInfo.StartDateTime.GetOrZero()
+				if scheduleInfo.Expiration != nil {
+					model.Duration = scheduleInfo.Expiration.Duration.GetOrZero()
+				}
+			}
+
+			// Once provisioned, the activation only remains in effect for as long as the resulting schedule exists. The
+			// schedule is removed when the activation expires or is deactivated outside of Terraform.
+			if targetScheduleId := request.TargetScheduleId.GetOrZero(); status == PrivilegedAccessGroupScheduleRequestStatusProvisioned && targetScheduleId != "" {
+				scheduleId := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleID(targetScheduleId)
+				scheduleResp, err := scheduleClient.GetPrivilegedAccessGroupAssignmentSchedule(ctx, scheduleId, privilegedaccessgroupassignmentschedule.DefaultGetPrivilegedAccessGroupAssignmentScheduleOperationOptions())
+				if err != nil {
+					if response.WasNotFound(scheduleResp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %v", scheduleId, err)
+				}
+
+				if schedule := scheduleResp.Model; schedule != nil {
+					model.StartDate = schedule.ScheduleInfo.StartDateTime.GetOrZero()
+					if schedule.ScheduleInfo.Expiration != nil {
+						model.ExpirationDate = schedule.ScheduleInfo.Expiration.EndDateTime.GetOrZero()
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r PrivilegedAccessGroupActivationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.IdentityGovernance.PrivilegedAccessGroupAssignmentScheduleRequestClient
+
+			id := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestID(metadata.ResourceData.Id())
+
+			var model PrivilegedAccessGroupActivationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			switch model.Status {
+			case PrivilegedAccessGroupScheduleRequestStatusGranted,
+				PrivilegedAccessGroupScheduleRequestStatusPendingAdminDecision,
+				PrivilegedAccessGroupScheduleRequestStatusPendingApproval,
+				PrivilegedAccessGroupScheduleRequestStatusPendingProvisioning,
+				PrivilegedAccessGroupScheduleRequestStatusPendingScheduleCreation,
+				PrivilegedAccessGroupScheduleRequestStatusScheduleCreated:
+				return cancelAssignmentRequest(ctx, metadata, client, id)
+
+			case PrivilegedAccessGroupScheduleRequestStatusProvisioned:
+				properties := stable.PrivilegedAccessGroupAssignmentScheduleRequest{
+					AccessId:    stable.PrivilegedAccessGroupRelationships(model.AssignmentType),
+					PrincipalId: nullable.Value(model.PrincipalId),
+					GroupId:     nullable.Value(model.GroupId),
+					Action:      pointer.To(stable.ScheduleRequestActions_SelfDeactivate),
+				}
+
+				resp, err := client.CreatePrivilegedAccessGroupAssignmentScheduleRequest(ctx, properties, privilegedaccessgroupassignmentschedulerequest.DefaultCreatePrivilegedAccessGroupAssignmentScheduleRequestOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("creating deactivation request for %s: %v", id, err)
+				}
+
+				request := resp.Model
+				if request == nil || request.Id == nil {
+					return fmt.Errorf("creating deactivation request for %s: model or ID was nil", id)
+				}
+
+				deactivationId := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestID(*request.Id)
+				if _, err = privilegedAccessGroupAssignmentScheduleRequestWait(ctx, client, deactivationId, []string{PrivilegedAccessGroupScheduleRequestStatusRevoked}); err != nil {
+					return fmt.Errorf("waiting for deactivation of %s: %v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
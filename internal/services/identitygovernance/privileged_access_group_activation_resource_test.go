@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedulerequest"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/helpers"
+)
+
+type PrivilegedAccessGroupActivationResource struct{}
+
+func TestPrivilegedAccessGroupActivation_member(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_privileged_access_group_activation", "member")
+	r := PrivilegedAccessGroupActivationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.member(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_id").IsUuid(),
+				check.That(data.ResourceName).Key("status").HasValue("Provisioned"),
+				check.That(data.ResourceName).Key("expiration_date").Exists(),
+				// An activation must be active for at least 5 minutes before it can be deactivated
+				helpers.SleepCheck(5*time.Minute+15*time.Second),
+			),
+		},
+	})
+}
+
+func (PrivilegedAccessGroupActivationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.PrivilegedAccessGroupAssignmentScheduleRequestClient
+	id := stable.NewIdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestID(state.ID)
+
+	resp, err := client.GetPrivilegedAccessGroupAssignmentScheduleRequest(ctx, id, privilegedaccessgroupassignmentschedulerequest.DefaultGetPrivilegedAccessGroupAssignmentScheduleRequestOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil && pointer.From(resp.Model.Status) == "Provisioned"), nil
+}
+
+func (PrivilegedAccessGroupActivationResource) member(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "current" {}
+
+resource "azuread_group" "pam" {
+  display_name     = "Privileged Activation %[1]s"
+  mail_enabled     = false
+  security_enabled = true
+}
+
+resource "azuread_privileged_access_group_eligibility_schedule" "member" {
+  group_id        = azuread_group.pam.id
+  principal_id    = data.azuread_client_config.current.object_id
+  assignment_type = "member"
+  duration        = "P1D"
+  justification   = "required"
+}
+
+resource "azuread_privileged_access_group_activation" "member" {
+  group_id        = azuread_privileged_access_group_eligibility_schedule.member.group_id
+  assignment_type = azuread_privileged_access_group_eligibility_schedule.member.assignment_type
+  duration        = "PT1H"
+  justification   = "acceptance test"
+  ticket_number   = "1234"
+  ticket_system   = "acctest"
+}
+`, data.RandomString)
+}
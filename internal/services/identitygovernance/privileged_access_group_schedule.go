@@ -4,12 +4,16 @@
 package identitygovernance
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupassignmentschedulerequest"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
@@ -184,3 +188,34 @@ func buildScheduleRequest(model *PrivilegedAccessGroupScheduleModel, metadata *s
 
 	return &schedule, nil
 }
+
+// privilegedAccessGroupAssignmentScheduleRequestWait waits for an assignment schedule request to reach one of the target
+// statuses, returning an error if the request fails, is denied, or is canceled or revoked before reaching a target status
+func privilegedAccessGroupAssignmentScheduleRequestWait(ctx context.Context, client *privilegedaccessgroupassignmentschedulerequest.PrivilegedAccessGroupAssignmentScheduleRequestClient, id stable.IdentityGovernancePrivilegedAccessGroupAssignmentScheduleRequestId, target []string) (*stable.PrivilegedAccessGroupAssignmentScheduleRequest, error) {
+	result, err := consistency.WaitForScheduleRequestStatus(ctx, target, func(ctx context.Context) (interface{}, *string, error) {
+		resp, err := client.GetPrivilegedAccessGroupAssignmentScheduleRequest(ctx, id, privilegedaccessgroupassignmentschedulerequest.DefaultGetPrivilegedAccessGroupAssignmentScheduleRequestOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+
+		request := resp.Model
+		if request == nil || request.Status == nil {
+			return nil, nil, fmt.Errorf("retrieving %s: model or status was nil", id)
+		}
+
+		return request, request.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, ok := result.(*stable.PrivilegedAccessGroupAssignmentScheduleRequest)
+	if !ok || request == nil {
+		return nil, fmt.Errorf("unexpected result waiting for %s", id)
+	}
+
+	return request, nil
+}
@@ -30,9 +30,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_access_package":              accessPackageDataSource(),
-		"azuread_access_package_catalog":      accessPackageCatalogDataSource(),
-		"azuread_access_package_catalog_role": accessPackageCatalogRoleDataSource(),
+		"azuread_access_package":                      accessPackageDataSource(),
+		"azuread_access_package_catalog":              accessPackageCatalogDataSource(),
+		"azuread_access_package_catalog_role":         accessPackageCatalogRoleDataSource(),
+		"azuread_access_review_instances":             accessReviewInstancesDataSource(),
+		"azuread_lifecycle_workflow_task_definitions": lifecycleWorkflowTaskDefinitionsDataSource(),
+		"azuread_terms_of_use_agreement_acceptances":  termsOfUseAgreementAcceptancesDataSource(),
 	}
 }
 
@@ -40,11 +43,19 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_access_package":                              accessPackageResource(),
+		"azuread_access_package_assignment":                   accessPackageAssignmentResource(),
 		"azuread_access_package_assignment_policy":            accessPackageAssignmentPolicyResource(),
 		"azuread_access_package_catalog":                      accessPackageCatalogResource(),
 		"azuread_access_package_catalog_role_assignment":      accessPackageCatalogRoleAssignmentResource(),
+		"azuread_access_package_custom_workflow_extension":    accessPackageCustomWorkflowExtensionResource(),
+		"azuread_access_package_incompatible_access_package":  accessPackageIncompatibleAccessPackageResource(),
+		"azuread_access_package_incompatible_group":           accessPackageIncompatibleGroupResource(),
 		"azuread_access_package_resource_catalog_association": accessPackageResourceCatalogAssociationResource(),
 		"azuread_access_package_resource_package_association": accessPackageResourcePackageAssociationResource(),
+		"azuread_access_review_schedule_definition":           accessReviewScheduleDefinitionResource(),
+		"azuread_connected_organization":                      connectedOrganizationResource(),
+		"azuread_lifecycle_workflow":                          lifecycleWorkflowResource(),
+		"azuread_terms_of_use_agreement":                      termsOfUseAgreementResource(),
 	}
 }
 
@@ -56,6 +67,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		PrivilegedAccessGroupActivationResource{},
 		PrivilegedAccessGroupAssignmentScheduleResource{},
 		PrivilegedAccessGroupEligibilityScheduleResource{},
 	}
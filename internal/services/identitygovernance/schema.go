@@ -76,3 +76,31 @@ func schemaUserSet() *pluginsdk.Resource {
 		},
 	}
 }
+
+func schemaAccessReviewQueryScope() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"query": {
+				Description:  "The query specifying who will be the reviewer, or which entities are in scope",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"query_type": {
+				Description:  "The type of query",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      AccessReviewQueryTypeMicrosoftGraph,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"query_root": {
+				Description:  "The root from which the query is evaluated, for example `decisions` when specifying the managers of the users being reviewed",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accesspackageassignmentrequest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// AccessPackageAssignmentRequestClient provides access to the /identityGovernance/entitlementManagement/assignmentRequests
+// endpoint. Admin assignment requests identify the target, policy and access package using properties which are not
+// present in the go-azure-sdk models, so this package uses its own models.
+type AccessPackageAssignmentRequestClient struct {
+	Client *msgraph.Client
+}
+
+func NewAccessPackageAssignmentRequestClientWithBaseURI(sdkApi sdkEnv.Api) (*AccessPackageAssignmentRequestClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "accesspackageassignmentrequest", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AccessPackageAssignmentRequestClient: %+v", err)
+	}
+
+	return &AccessPackageAssignmentRequestClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accesspackageassignmentrequest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type GetAccessPackageAssignmentRequestOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *AccessPackageAssignmentRequest
+}
+
+type GetAccessPackageAssignmentRequestOperationOptions struct {
+	Expand    *odata.Expand
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultGetAccessPackageAssignmentRequestOperationOptions() GetAccessPackageAssignmentRequestOperationOptions {
+	return GetAccessPackageAssignmentRequestOperationOptions{}
+}
+
+func (o GetAccessPackageAssignmentRequestOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o GetAccessPackageAssignmentRequestOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Expand != nil {
+		out.Expand = *o.Expand
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o GetAccessPackageAssignmentRequestOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// GetAccessPackageAssignmentRequest - Get accessPackageAssignmentRequest. In Microsoft Entra entitlement management,
+// retrieve the properties and relationships of an accessPackageAssignmentRequest object.
+func (c AccessPackageAssignmentRequestClient) GetAccessPackageAssignmentRequest(ctx context.Context, id stable.IdentityGovernanceEntitlementManagementAssignmentRequestId, options GetAccessPackageAssignmentRequestOperationOptions) (result GetAccessPackageAssignmentRequestOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Path:          id.ID(),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model AccessPackageAssignmentRequest
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
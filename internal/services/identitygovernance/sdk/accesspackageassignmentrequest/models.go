@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accesspackageassignmentrequest
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+type AccessPackageAssignmentRequest struct {
+	Assignment        *AccessPackageAssignment              `json:"assignment,omitempty"`
+	CompletedDateTime nullable.Type[string]                 `json:"completedDateTime,omitempty"`
+	CreatedDateTime   nullable.Type[string]                 `json:"createdDateTime,omitempty"`
+	Id                *string                               `json:"id,omitempty"`
+	Justification     nullable.Type[string]                 `json:"justification,omitempty"`
+	RequestType       *stable.AccessPackageRequestType      `json:"requestType,omitempty"`
+	Schedule          *stable.EntitlementManagementSchedule `json:"schedule,omitempty"`
+	State             *stable.AccessPackageRequestState     `json:"state,omitempty"`
+	Status            nullable.Type[string]                 `json:"status,omitempty"`
+}
+
+// AccessPackageAssignment identifies the assignment to be created or removed by a request. New assignments are
+// identified by their target, policy and access package, and existing assignments by their ID.
+type AccessPackageAssignment struct {
+	AccessPackageId    *string                              `json:"accessPackageId,omitempty"`
+	AssignmentPolicyId *string                              `json:"assignmentPolicyId,omitempty"`
+	Id                 *string                              `json:"id,omitempty"`
+	State              *stable.AccessPackageAssignmentState `json:"state,omitempty"`
+	TargetId           *string                              `json:"targetId,omitempty"`
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessreviewdefinition
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// AccessReviewDefinitionClient provides access to the /identityGovernance/accessReviews/definitions endpoint. The
+// go-azure-sdk models for this endpoint are unable to unmarshal query scopes, so this package uses its own models.
+type AccessReviewDefinitionClient struct {
+	Client *msgraph.Client
+}
+
+func NewAccessReviewDefinitionClientWithBaseURI(sdkApi sdkEnv.Api) (*AccessReviewDefinitionClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "accessreviewdefinition", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AccessReviewDefinitionClient: %+v", err)
+	}
+
+	return &AccessReviewDefinitionClient{
+		Client: client,
+	}, nil
+}
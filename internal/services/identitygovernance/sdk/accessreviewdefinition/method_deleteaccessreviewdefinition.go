@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessreviewdefinition
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type DeleteAccessReviewDefinitionOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type DeleteAccessReviewDefinitionOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultDeleteAccessReviewDefinitionOperationOptions() DeleteAccessReviewDefinitionOperationOptions {
+	return DeleteAccessReviewDefinitionOperationOptions{}
+}
+
+func (o DeleteAccessReviewDefinitionOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o DeleteAccessReviewDefinitionOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o DeleteAccessReviewDefinitionOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// DeleteAccessReviewDefinition - Delete accessReviewScheduleDefinition. Deletes an accessReviewScheduleDefinition
+// object.
+func (c AccessReviewDefinitionClient) DeleteAccessReviewDefinition(ctx context.Context, id stable.IdentityGovernanceAccessReviewDefinitionId, options DeleteAccessReviewDefinitionOperationOptions) (result DeleteAccessReviewDefinitionOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: options,
+		Path:          id.ID(),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessreviewdefinition
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListAccessReviewDefinitionInstancesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]AccessReviewInstance
+}
+
+type ListAccessReviewDefinitionInstancesOperationOptions struct {
+	Filter    *string
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultListAccessReviewDefinitionInstancesOperationOptions() ListAccessReviewDefinitionInstancesOperationOptions {
+	return ListAccessReviewDefinitionInstancesOperationOptions{}
+}
+
+func (o ListAccessReviewDefinitionInstancesOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o ListAccessReviewDefinitionInstancesOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Filter != nil {
+		out.Filter = *o.Filter
+	}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o ListAccessReviewDefinitionInstancesOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+type ListAccessReviewDefinitionInstancesCustomPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *ListAccessReviewDefinitionInstancesCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// ListAccessReviewDefinitionInstances - List instances. Get a list of the accessReviewInstance objects and their
+// properties.
+func (c AccessReviewDefinitionClient) ListAccessReviewDefinitionInstances(ctx context.Context, id stable.IdentityGovernanceAccessReviewDefinitionId, options ListAccessReviewDefinitionInstancesOperationOptions) (result ListAccessReviewDefinitionInstancesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListAccessReviewDefinitionInstancesCustomPager{},
+		Path:          fmt.Sprintf("%s/instances", id.ID()),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]AccessReviewInstance `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
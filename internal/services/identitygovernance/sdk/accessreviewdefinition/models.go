@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessreviewdefinition
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+const (
+	ODataTypeAccessReviewQueryScope              = "#microsoft.graph.accessReviewQueryScope"
+	ODataTypeAccessReviewInactiveUsersQueryScope = "#microsoft.graph.accessReviewInactiveUsersQueryScope"
+	ODataTypePrincipalResourceMembershipsScope   = "#microsoft.graph.principalResourceMembershipsScope"
+)
+
+type AccessReviewScheduleDefinition struct {
+	DescriptionForAdmins     nullable.Type[string]                `json:"descriptionForAdmins,omitempty"`
+	DescriptionForReviewers  nullable.Type[string]                `json:"descriptionForReviewers,omitempty"`
+	DisplayName              nullable.Type[string]                `json:"displayName,omitempty"`
+	FallbackReviewers        *[]stable.AccessReviewReviewerScope  `json:"fallbackReviewers,omitempty"`
+	Id                       *string                              `json:"id,omitempty"`
+	InstanceEnumerationScope *AccessReviewScope                   `json:"instanceEnumerationScope,omitempty"`
+	Reviewers                *[]stable.AccessReviewReviewerScope  `json:"reviewers,omitempty"`
+	Scope                    *AccessReviewScope                   `json:"scope,omitempty"`
+	Settings                 *stable.AccessReviewScheduleSettings `json:"settings,omitempty"`
+	Status                   nullable.Type[string]                `json:"status,omitempty"`
+}
+
+// AccessReviewScope holds the properties of all supported access review scope types, which are distinguished by
+// their OData type
+type AccessReviewScope struct {
+	InactiveDuration nullable.Type[string] `json:"inactiveDuration,omitempty"`
+	ODataType        *string               `json:"@odata.type,omitempty"`
+	PrincipalScopes  *[]AccessReviewScope  `json:"principalScopes,omitempty"`
+	Query            nullable.Type[string] `json:"query,omitempty"`
+	QueryRoot        nullable.Type[string] `json:"queryRoot,omitempty"`
+	QueryType        nullable.Type[string] `json:"queryType,omitempty"`
+	ResourceScopes   *[]AccessReviewScope  `json:"resourceScopes,omitempty"`
+}
+
+type AccessReviewInstance struct {
+	EndDateTime   nullable.Type[string] `json:"endDateTime,omitempty"`
+	Id            *string               `json:"id,omitempty"`
+	Scope         *AccessReviewScope    `json:"scope,omitempty"`
+	StartDateTime nullable.Type[string] `json:"startDateTime,omitempty"`
+	Status        nullable.Type[string] `json:"status,omitempty"`
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package termsofuseagreementupload
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// TermsOfUseAgreementUploadClient creates terms of use agreements along with their uploaded files. The models in the
+// identitygovernance/stable/termsofuseagreement package in go-azure-sdk omit file data when marshaling, so this package
+// uses its own models. Other operations on agreements should use the go-azure-sdk package.
+type TermsOfUseAgreementUploadClient struct {
+	Client *msgraph.Client
+}
+
+func NewTermsOfUseAgreementUploadClientWithBaseURI(sdkApi sdkEnv.Api) (*TermsOfUseAgreementUploadClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "termsofuseagreement", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TermsOfUseAgreementUploadClient: %+v", err)
+	}
+
+	return &TermsOfUseAgreementUploadClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package termsofuseagreementupload
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+type Agreement struct {
+	DisplayName                       nullable.Type[string]        `json:"displayName,omitempty"`
+	Files                             *[]AgreementFileLocalization `json:"files,omitempty"`
+	Id                                *string                      `json:"id,omitempty"`
+	IsPerDeviceAcceptanceRequired     nullable.Type[bool]          `json:"isPerDeviceAcceptanceRequired,omitempty"`
+	IsViewingBeforeAcceptanceRequired nullable.Type[bool]          `json:"isViewingBeforeAcceptanceRequired,omitempty"`
+	TermsExpiration                   *stable.TermsExpiration      `json:"termsExpiration,omitempty"`
+	UserReacceptRequiredFrequency     nullable.Type[string]        `json:"userReacceptRequiredFrequency,omitempty"`
+}
+
+type AgreementFileLocalization struct {
+	DisplayName nullable.Type[string] `json:"displayName,omitempty"`
+	FileData    *AgreementFileData    `json:"fileData,omitempty"`
+	FileName    nullable.Type[string] `json:"fileName,omitempty"`
+	Id          *string               `json:"id,omitempty"`
+	IsDefault   nullable.Type[bool]   `json:"isDefault,omitempty"`
+	Language    nullable.Type[string] `json:"language,omitempty"`
+}
+
+// AgreementFileData holds the base64 encoded content of an agreement file
+type AgreementFileData struct {
+	Data nullable.Type[string] `json:"data,omitempty"`
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package termsofuseagreementupload
+
+const defaultApiVersion = "v1.0"
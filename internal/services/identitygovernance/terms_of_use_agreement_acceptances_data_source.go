@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreementacceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func termsOfUseAgreementAcceptancesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: termsOfUseAgreementAcceptancesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"agreement_id": {
+				Description:  "The ID of the terms of use agreement",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"user_id": {
+				Description:  "Only return acceptances recorded for the user with this object ID",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"acceptances": {
+				Description: "The acceptances recorded for the terms of use agreement",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the acceptance",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"agreement_file_id": {
+							Description: "The ID of the agreement file which was accepted",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"device_id": {
+							Description: "The ID of the device on which the agreement was accepted, when per-device acceptance is required",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"expiration_date_time": {
+							Description: "The date and time when the acceptance expires",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"recorded_date_time": {
+							Description: "The date and time when the acceptance was recorded",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"state": {
+							Description: "The state of the acceptance, either `accepted` or `declined`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"user_id": {
+							Description: "The object ID of the user who accepted or declined the agreement",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"user_principal_name": {
+							Description: "The user principal name of the user who accepted or declined the agreement",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func termsOfUseAgreementAcceptancesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementAcceptanceClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Get("agreement_id").(string))
+	userId := d.Get("user_id").(string)
+
+	resp, err := client.ListTermsOfUseAgreementAcceptances(ctx, id, termsofuseagreementacceptance.DefaultListTermsOfUseAgreementAcceptancesOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "agreement_id", "Listing acceptances for %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Bad API Response")
+	}
+
+	acceptanceIds := make([]string, 0)
+	acceptances := make([]map[string]interface{}, 0)
+	for _, acceptance := range *resp.Model {
+		if userId != "" && !strings.EqualFold(acceptance.UserId.GetOrZero(), userId) {
+			continue
+		}
+
+		acceptanceId := pointer.From(acceptance.Id)
+		acceptanceIds = append(acceptanceIds, acceptanceId)
+
+		acceptances = append(acceptances, map[string]interface{}{
+			"id":                   acceptanceId,
+			"agreement_file_id":    acceptance.AgreementFileId.GetOrZero(),
+			"device_id":            acceptance.DeviceId.GetOrZero(),
+			"expiration_date_time": acceptance.ExpirationDateTime.GetOrZero(),
+			"recorded_date_time":   acceptance.RecordedDateTime.GetOrZero(),
+			"state":                string(pointer.From(acceptance.State)),
+			"user_id":              acceptance.UserId.GetOrZero(),
+			"user_principal_name":  acceptance.UserPrincipalName.GetOrZero(),
+		})
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(id.AgreementId + "/" + strings.Join(acceptanceIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for acceptance IDs")
+	}
+
+	d.SetId("termsOfUseAgreementAcceptances#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "acceptances", acceptances)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type TermsOfUseAgreementAcceptancesDataSource struct{}
+
+func TestAccTermsOfUseAgreementAcceptancesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_terms_of_use_agreement_acceptances", "test")
+	r := TermsOfUseAgreementAcceptancesDataSource{}
+	path := writeTermsOfUsePdf(t, "terms.pdf", "Terms of use")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("acceptances.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (TermsOfUseAgreementAcceptancesDataSource) basic(data acceptance.TestData, path string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_terms_of_use_agreement_acceptances" "test" {
+  agreement_id = azuread_terms_of_use_agreement.test.id
+}
+`, TermsOfUseAgreementResource{}.basic(data, path))
+}
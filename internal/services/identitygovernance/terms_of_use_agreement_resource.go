@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/sdk/termsofuseagreementupload"
+)
+
+func termsOfUseAgreementResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: termsOfUseAgreementResourceCreate,
+		ReadContext:   termsOfUseAgreementResourceRead,
+		UpdateContext: termsOfUseAgreementResourceUpdate,
+		DeleteContext: termsOfUseAgreementResourceDelete,
+
+		CustomizeDiff: termsOfUseAgreementResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the agreement, which is shown to users and in conditional access policies",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"file": {
+				Description: "The PDF files containing the terms of use, one for each language",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"language": {
+							Description:  "The language of the file, as a culture code (e.g. en-US)",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"path": {
+							Description:  "The path to a local PDF file containing the terms of use",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"file_name": {
+							Description:  "The name of the file shown to users. Defaults to the base name of `path`",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"is_default": {
+							Description: "Whether this is the default file, which is shown to users whose language does not match any of the files",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+
+			"per_device_acceptance_required": {
+				Description: "Whether users must accept the agreement on every device they use to access resources",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"user_reaccept_required_frequency": {
+				Description:  "How often users must accept the agreement again, formatted as an ISO8601 duration (e.g. P90D)",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"viewing_before_acceptance_required": {
+				Description: "Whether users must expand and view the agreement before accepting it",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"files_hash": {
+				Description: "The SHA-256 hash of the uploaded files, used to detect changes to the file content",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func termsOfUseAgreementResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	files := diff.Get("file").([]interface{})
+
+	defaults := 0
+	for _, raw := range files {
+		if file, ok := raw.(map[string]interface{}); ok && file["is_default"].(bool) {
+			defaults++
+		}
+	}
+	if len(files) > 1 && defaults != 1 {
+		return errors.New("exactly one `file` block must have `is_default` set to `true` when more than one file is specified")
+	}
+
+	// Track the content of the files, so that changes to a file are detected even when the path is unchanged. Agreement
+	// files cannot be replaced, so a new agreement must be created when any of the file content changes.
+	if !diff.NewValueKnown("file") {
+		return diff.SetNewComputed("files_hash")
+	}
+
+	filesHash, err := termsOfUseAgreementFilesHash(files)
+	if err != nil {
+		return err
+	}
+
+	if filesHash != diff.Get("files_hash").(string) {
+		if err = diff.SetNew("files_hash", filesHash); err != nil {
+			return fmt.Errorf("setting `files_hash`: %v", err)
+		}
+		if diff.Id() != "" {
+			if err = diff.ForceNew("files_hash"); err != nil {
+				return fmt.Errorf("forcing replacement for `files_hash`: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func termsOfUseAgreementResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+	uploadClient := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementUploadClient
+	displayName := d.Get("display_name").(string)
+
+	files, err := expandTermsOfUseAgreementFiles(displayName, d.Get("file").([]interface{}))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "file", "Could not load files for terms of use agreement %q", displayName)
+	}
+
+	properties := termsofuseagreementupload.Agreement{
+		DisplayName:                       nullable.Value(displayName),
+		Files:                             files,
+		IsPerDeviceAcceptanceRequired:     nullable.Value(d.Get("per_device_acceptance_required").(bool)),
+		IsViewingBeforeAcceptanceRequired: nullable.Value(d.Get("viewing_before_acceptance_required").(bool)),
+		UserReacceptRequiredFrequency:     nullable.NoZero(d.Get("user_reaccept_required_frequency").(string)),
+	}
+
+	resp, err := uploadClient.CreateTermsOfUseAgreement(ctx, properties, termsofuseagreementupload.DefaultCreateTermsOfUseAgreementOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating terms of use agreement %q", displayName)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating terms of use agreement")
+	}
+	if resp.Model.Id == nil {
+		return tf.ErrorDiagF(errors.New("ID was nil"), "Creating terms of use agreement")
+	}
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(*resp.Model.Id)
+	d.SetId(id.AgreementId)
+
+	filesHash, err := termsOfUseAgreementFilesHash(d.Get("file").([]interface{}))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "file", "Could not load files for %s", id)
+	}
+	tf.Set(d, "files_hash", filesHash)
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return termsOfUseAgreementResourceRead(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	properties := stable.Agreement{
+		DisplayName:                       nullable.Value(d.Get("display_name").(string)),
+		IsPerDeviceAcceptanceRequired:     nullable.Value(d.Get("per_device_acceptance_required").(bool)),
+		IsViewingBeforeAcceptanceRequired: nullable.Value(d.Get("viewing_before_acceptance_required").(bool)),
+		UserReacceptRequiredFrequency:     nullable.NoZero(d.Get("user_reaccept_required_frequency").(string)),
+	}
+
+	if _, err := client.UpdateTermsOfUseAgreement(ctx, id, properties, termsofuseagreement.DefaultUpdateTermsOfUseAgreementOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return termsOfUseAgreementResourceRead(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	options := termsofuseagreement.GetTermsOfUseAgreementOperationOptions{
+		Expand: &odata.Expand{Relationship: "files"},
+	}
+
+	resp, err := client.GetTermsOfUseAgreement(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	agreement := resp.Model
+	if agreement == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "display_name", agreement.DisplayName.GetOrZero())
+	tf.Set(d, "per_device_acceptance_required", agreement.IsPerDeviceAcceptanceRequired.GetOrZero())
+	tf.Set(d, "user_reaccept_required_frequency", agreement.UserReacceptRequiredFrequency.GetOrZero())
+	tf.Set(d, "viewing_before_acceptance_required", agreement.IsViewingBeforeAcceptanceRequired.GetOrZero())
+
+	if agreement.Files != nil {
+		tf.Set(d, "file", flattenTermsOfUseAgreementFiles(agreement.Files, d.Get("file").([]interface{})))
+	}
+
+	return nil
+}
+
+func termsOfUseAgreementResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	if _, err := client.DeleteTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultDeleteTermsOfUseAgreementOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+// termsOfUseAgreementReadFile returns the content of a terms of use file, which must be a PDF document
+func termsOfUseAgreementReadFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file %q: %v", path, err)
+	}
+
+	if contentType := http.DetectContentType(data); contentType != "application/pdf" {
+		return nil, fmt.Errorf("file %q is not a PDF document, detected MIME type: %q", path, contentType)
+	}
+
+	return data, nil
+}
+
+// termsOfUseAgreementFilesHash returns a hash of the language and content of each of the specified files
+func termsOfUseAgreementFilesHash(input []interface{}) (string, error) {
+	hash := sha256.New()
+
+	for _, raw := range input {
+		file, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		data, err := termsOfUseAgreementReadFile(file["path"].(string))
+		if err != nil {
+			return "", err
+		}
+
+		hash.Write([]byte(file["language"].(string)))
+		hash.Write(data)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func expandTermsOfUseAgreementFiles(displayName string, input []interface{}) (*[]termsofuseagreementupload.AgreementFileLocalization, error) {
+	result := make([]termsofuseagreementupload.AgreementFileLocalization, 0)
+
+	for _, raw := range input {
+		file, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := file["path"].(string)
+		data, err := termsOfUseAgreementReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := file["file_name"].(string)
+		if fileName == "" {
+			fileName = filepath.Base(path)
+		}
+
+		result = append(result, termsofuseagreementupload.AgreementFileLocalization{
+			DisplayName: nullable.Value(displayName),
+			FileData: &termsofuseagreementupload.AgreementFileData{
+				Data: nullable.Value(base64.StdEncoding.EncodeToString(data)),
+			},
+			FileName:  nullable.Value(fileName),
+			IsDefault: nullable.Value(len(input) == 1 || file["is_default"].(bool)),
+			Language:  nullable.Value(file["language"].(string)),
+		})
+	}
+
+	return &result, nil
+}
+
+// flattenTermsOfUseAgreementFiles returns the files for an agreement, retaining the configured path for each language
+// since the original location of the uploaded files cannot be determined from the API
+func flattenTermsOfUseAgreementFiles(input *[]stable.AgreementFileLocalization, existing []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	paths := make(map[string]string)
+	positions := make(map[string]int)
+	for i, raw := range existing {
+		if file, ok := raw.(map[string]interface{}); ok {
+			language := strings.ToLower(file["language"].(string))
+			paths[language] = file["path"].(string)
+			positions[language] = i
+		}
+	}
+
+	// Retain the configured ordering of files, with any unknown files at the end
+	files := *input
+	position := func(file stable.AgreementFileLocalization) int {
+		if i, ok := positions[strings.ToLower(file.Language.GetOrZero())]; ok {
+			return i
+		}
+		return len(existing)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return position(files[i]) < position(files[j])
+	})
+
+	for _, file := range files {
+		language := file.Language.GetOrZero()
+		result = append(result, map[string]interface{}{
+			"file_name":  file.FileName.GetOrZero(),
+			"is_default": file.IsDefault.GetOrZero(),
+			"language":   language,
+			"path":       paths[strings.ToLower(language)],
+		})
+	}
+
+	return result
+}
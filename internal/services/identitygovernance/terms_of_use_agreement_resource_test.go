@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type TermsOfUseAgreementResource struct{}
+
+func TestAccTermsOfUseAgreement_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+	path := writeTermsOfUsePdf(t, "terms.pdf", "Terms of use")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.0.file_name").HasValue("terms.pdf"),
+				check.That(data.ResourceName).Key("file.0.is_default").HasValue("true"),
+				check.That(data.ResourceName).Key("files_hash").Exists(),
+			),
+		},
+		data.ImportStep("file.0.path", "files_hash"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+	pathEn := writeTermsOfUsePdf(t, "terms-en.pdf", "Terms of use")
+	pathFr := writeTermsOfUsePdf(t, "terms-fr.pdf", "Conditions d'utilisation")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, pathEn, pathFr),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("2"),
+				check.That(data.ResourceName).Key("per_device_acceptance_required").HasValue("true"),
+				check.That(data.ResourceName).Key("viewing_before_acceptance_required").HasValue("true"),
+			),
+		},
+		data.ImportStep("file.0.path", "file.1.path", "files_hash"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+	path := writeTermsOfUsePdf(t, "terms.pdf", "Terms of use")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file.0.path", "files_hash"),
+		{
+			Config: r.updated(data, path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_reaccept_required_frequency").HasValue("P90D"),
+				check.That(data.ResourceName).Key("viewing_before_acceptance_required").HasValue("true"),
+			),
+		},
+		data.ImportStep("file.0.path", "files_hash"),
+		{
+			Config: r.basic(data, path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_reaccept_required_frequency").HasValue(""),
+			),
+		},
+		data.ImportStep("file.0.path", "files_hash"),
+	})
+}
+
+func (TermsOfUseAgreementResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.TermsOfUseAgreementClient
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(state.ID)
+
+	resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (TermsOfUseAgreementResource) basic(data acceptance.TestData, path string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name = "acctest-TOU-%[1]d"
+
+  file {
+    language = "en-US"
+    path     = %[2]q
+  }
+}
+`, data.RandomInteger, path)
+}
+
+func (TermsOfUseAgreementResource) updated(data acceptance.TestData, path string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                       = "acctest-TOU-updated-%[1]d"
+  user_reaccept_required_frequency   = "P90D"
+  viewing_before_acceptance_required = true
+
+  file {
+    language = "en-US"
+    path     = %[2]q
+  }
+}
+`, data.RandomInteger, path)
+}
+
+func (TermsOfUseAgreementResource) complete(data acceptance.TestData, pathEn, pathFr string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                       = "acctest-TOU-%[1]d"
+  per_device_acceptance_required     = true
+  user_reaccept_required_frequency   = "P30D"
+  viewing_before_acceptance_required = true
+
+  file {
+    language   = "en-US"
+    path       = %[2]q
+    file_name  = "Terms of Use.pdf"
+    is_default = true
+  }
+
+  file {
+    language = "fr-FR"
+    path     = %[3]q
+  }
+}
+`, data.RandomInteger, pathEn, pathFr)
+}
+
+// writeTermsOfUsePdf writes a single page PDF document containing the specified text, returning the path to the file
+func writeTermsOfUsePdf(t *testing.T, name, text string) string {
+	content := fmt.Sprintf("BT /F1 24 Tf 72 720 Td (%s) Tj ET", text)
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, 0, len(objects))
+	for i, object := range objects {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("writing %q: %v", path, err)
+	}
+
+	return path
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+)
+
+func AccessPackageIncompatibleAccessPackageID(input string) (err error) {
+	_, err = parse.AccessPackageIncompatibleAccessPackageID(input)
+	return
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+var membershipRuleOperators = []string{
+	"-all", "-and", "-any", "-contains", "-eq", "-ge", "-gt", "-in", "-le", "-lt", "-match", "-ne", "-not", "-notcontains",
+	"-notin", "-notmatch", "-notstartswith", "-or", "-startswith",
+}
+
+var (
+	membershipRuleOperatorRegex = regexp.MustCompile(`(^|[\s(])(-[A-Za-z]+)`)
+	membershipRulePropertyRegex = regexp.MustCompile(`(^|[\s(])([A-Za-z]+)\.[A-Za-z0-9_]+`)
+	membershipRuleStringRegex   = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// AutoAssignmentMembershipRule checks whether a value is a plausible membership rule for an access package
+// auto-assignment policy, which may only refer to properties of users. The rule is not fully parsed, instead the
+// parentheses, quoting, operators and property references are checked so that obvious mistakes are caught at plan time.
+func AutoAssignmentMembershipRule(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Membership rule must not be empty",
+			AttributePath: path,
+		})
+		return
+	}
+
+	// Remove string literals, so that their contents are not mistaken for operators or properties
+	rule := membershipRuleStringRegex.ReplaceAllString(v, `""`)
+
+	if strings.Count(rule, `"`)%2 != 0 {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Membership rule contains an unterminated string",
+			AttributePath: path,
+		})
+		return
+	}
+
+	depth := 0
+	for _, c := range rule {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 {
+			break
+		}
+	}
+	if depth != 0 {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Membership rule contains unbalanced parentheses",
+			AttributePath: path,
+		})
+	}
+
+	for _, match := range membershipRuleOperatorRegex.FindAllStringSubmatch(rule, -1) {
+		if operator := strings.ToLower(match[2]); !slices.Contains(membershipRuleOperators, operator) {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Membership rule contains an unsupported operator %q", match[2]),
+				AttributePath: path,
+			})
+		}
+	}
+
+	hasUserProperty := false
+	for _, match := range membershipRulePropertyRegex.FindAllStringSubmatch(rule, -1) {
+		switch strings.ToLower(match[2]) {
+		case "user":
+			hasUserProperty = true
+		case "device":
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Membership rule must not refer to device properties, only users can be automatically assigned",
+				AttributePath: path,
+			})
+		}
+	}
+
+	if !hasUserProperty {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Membership rule must refer to at least one user property, e.g. `user.department`",
+			AttributePath: path,
+		})
+	}
+
+	return // nolint:nakedret
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestAutoAssignmentMembershipRule(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    `(user.department -eq "Sales")`,
+			TestName: "Valid_Simple",
+			ErrCount: 0,
+		},
+		{
+			Value:    `(user.department -eq "Sales") -and (user.country -in ["GB","IE"])`,
+			TestName: "Valid_Compound",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.jobTitle -startsWith "Engineer (contract)"`,
+			TestName: "Valid_ParenthesesInString",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.assignedPlans -any (assignedPlan.servicePlanId -eq "efb87545-963c-4e0d-99df-69c6916d9eb0")`,
+			TestName: "Valid_Lambda",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(user.department -eq "Sales"`,
+			TestName: "Invalid_UnbalancedParentheses",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(user.department -eq "Sales)`,
+			TestName: "Invalid_UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(user.department -equals "Sales")`,
+			TestName: "Invalid_Operator",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(device.deviceOSType -eq "Windows")`,
+			TestName: "Invalid_DeviceProperty",
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := AutoAssignmentMembershipRule(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected AutoAssignmentMembershipRule to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.Value)
+			}
+		})
+	}
+}
@@ -30,11 +30,13 @@ func invitationResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: invitationResourceCreate,
 		ReadContext:   invitationResourceRead,
+		UpdateContext: invitationResourceUpdate,
 		DeleteContext: invitationResourceDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -48,10 +50,9 @@ func invitationResource() *pluginsdk.Resource {
 			},
 
 			"user_email_address": {
-				Description:  "The email address of the user being invited",
+				Description:  "The email address of the user being invited. Changing this resets the redemption status of the invited user and sends a new invitation, retaining the existing user object",
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsEmailAddress,
 			},
 
@@ -207,6 +208,54 @@ func invitationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, me
 	return invitationResourceRead(ctx, d, meta)
 }
 
+func invitationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Invitations.InvitationClient
+	userClient := meta.(*clients.Client).Invitations.UserClient
+	userId := stable.NewUserID(d.Get("user_id").(string))
+
+	if d.HasChange("user_email_address") {
+		emailAddress := d.Get("user_email_address").(string)
+
+		// The mail property of the user must be updated before the redemption status can be reset with the new address
+		if _, err := userClient.UpdateUser(ctx, userId, stable.User{
+			Mail: nullable.Value(emailAddress),
+		}, user.DefaultUpdateUserOperationOptions()); err != nil {
+			return tf.ErrorDiagPathF(err, "user_email_address", "Updating email address for invited %s", userId)
+		}
+
+		properties := stable.Invitation{
+			InvitedUser: &stable.User{
+				Id: pointer.To(userId.UserId),
+			},
+			InvitedUserEmailAddress: emailAddress,
+			InviteRedirectUrl:       d.Get("redirect_url").(string),
+			ResetRedemption:         nullable.Value(true),
+		}
+
+		if v, ok := d.GetOk("message"); ok {
+			properties.SendInvitationMessage = nullable.Value(true)
+			properties.InvitedUserMessageInfo = expandInvitedUserMessageInfo(v.([]interface{}))
+		}
+
+		resp, err := client.CreateInvitation(ctx, properties, invitation.DefaultCreateInvitationOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Resetting redemption status for invited %s", userId)
+		}
+
+		invite := resp.Model
+		if invite == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Resetting redemption status for invited %s", userId)
+		}
+
+		if invite.InviteRedeemUrl.GetOrZero() == "" {
+			return tf.ErrorDiagF(errors.New("Bad API response"), "Redeem URL returned for invitation is nil/empty")
+		}
+		tf.Set(d, "redeem_url", invite.InviteRedeemUrl.GetOrZero())
+	}
+
+	return invitationResourceRead(ctx, d, meta)
+}
+
 func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Invitations.UserClient
 	userId := stable.NewUserID(d.Get("user_id").(string))
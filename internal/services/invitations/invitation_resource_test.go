@@ -125,6 +125,30 @@ func TestAccInvitation_messageWithLanguage(t *testing.T) {
 	})
 }
 
+func TestAccInvitation_updateEmailAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+	r := InvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_email_address").HasValue(fmt.Sprintf("acctest-user-%s@test.com", data.RandomString)),
+			),
+		},
+		{
+			Config: r.updatedEmailAddress(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("redeem_url").Exists(),
+				check.That(data.ResourceName).Key("user_email_address").HasValue(fmt.Sprintf("acctest-user-updated-%s@test.com", data.RandomString)),
+				check.That(data.ResourceName).Key("user_id").Exists(),
+			),
+		},
+	})
+}
+
 func TestAccInvitation_withGroupMembership(t *testing.T) {
 	count := 10
 	data := acceptance.BuildTestData(t, "azuread_invitation", fmt.Sprintf("test.%d", count-1))
@@ -164,6 +188,15 @@ resource "azuread_invitation" "test" {
 `, data.RandomString)
 }
 
+func (InvitationResource) updatedEmailAddress(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-user-updated-%[1]s@test.com"
+}
+`, data.RandomString)
+}
+
 func (InvitationResource) member(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_invitation" "test" {
@@ -0,0 +1,971 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicyauthenticationmethodconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// authenticationMethodAllUsers is the target ID used to include all users in an authentication method policy
+const authenticationMethodAllUsers = "all_users"
+
+func authenticationMethodPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationMethodPolicyResourceCreate,
+		ReadContext:   authenticationMethodPolicyResourceRead,
+		UpdateContext: authenticationMethodPolicyResourceUpdate,
+		DeleteContext: authenticationMethodPolicyResourceDelete,
+
+		CustomizeDiff: authenticationMethodPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			parsed, err := stable.ParsePolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(id)
+			if err != nil {
+				return err
+			}
+			if _, errs := validation.StringInSlice(possibleValuesForAuthenticationMethod, false)(parsed.AuthenticationMethodConfigurationId, "id"); len(errs) > 0 {
+				return errs[0]
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"method": {
+				Description:  "The authentication method to configure",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForAuthenticationMethod, false),
+			},
+
+			"state": {
+				Description:  "Whether the authentication method is enabled",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(stable.AuthenticationMethodState_Enabled),
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationMethodState(), false),
+			},
+
+			"include_target": {
+				Description: "The users or groups who are enabled to use the authentication method",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:  "The object ID of the group or user, or `all_users`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_type": {
+							Description:  "The type of the target",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.AuthenticationMethodTargetType_Group),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationMethodTargetType(), false),
+						},
+
+						"registration_required": {
+							Description: "Whether users are required to register the authentication method",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"authentication_mode": {
+							Description:  "The authentication mode allowed for the target, only applicable to the `MicrosoftAuthenticator` method",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.MicrosoftAuthenticatorAuthenticationMode_Any),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForMicrosoftAuthenticatorAuthenticationMode(), false),
+						},
+
+						"usable_for_sign_in": {
+							Description: "Whether the target can use the authentication method for sign-in, only applicable to the `Sms` method",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"exclude_target": {
+				Description: "The users or groups who are excluded from using the authentication method",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:  "The object ID of the group or user",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"target_type": {
+							Description:  "The type of the target",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.AuthenticationMethodTargetType_Group),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationMethodTargetType(), false),
+						},
+					},
+				},
+			},
+
+			"email": {
+				Description: "Settings for the `Email` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_external_id_to_use_email_otp": {
+							Description:  "Whether email one-time passcodes can be used by external ID users for self-service sign-up",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForExternalEmailOtpState(), false),
+						},
+					},
+				},
+			},
+
+			"fido2": {
+				Description: "Settings for the `Fido2` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"attestation_enforced": {
+							Description: "Whether attestation must be enforced for FIDO2 security key registration",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"self_service_registration_allowed": {
+							Description: "Whether users can register new FIDO2 security keys",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"key_restrictions": {
+							Description: "Restrictions on the FIDO2 security keys which can be registered",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"aaguids": {
+										Description: "The authenticator attestation GUIDs (AAGUIDs) of the security keys to allow or block",
+										Type:        pluginsdk.TypeSet,
+										Optional:    true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsUUID,
+										},
+									},
+
+									"enforced": {
+										Description: "Whether the key restrictions are enforced",
+										Type:        pluginsdk.TypeBool,
+										Optional:    true,
+									},
+
+									"enforcement_type": {
+										Description:  "Whether the specified AAGUIDs are allowed or blocked",
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Default:      string(stable.Fido2RestrictionEnforcementType_Allow),
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForFido2RestrictionEnforcementType(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"microsoft_authenticator": {
+				Description: "Settings for the `MicrosoftAuthenticator` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_app_information": authenticationMethodFeatureConfigurationSchema("Whether the application name is shown in push notifications"),
+
+						"display_location_information": authenticationMethodFeatureConfigurationSchema("Whether the geographic location of the sign-in is shown in push notifications"),
+
+						"software_oath_enabled": {
+							Description: "Whether users can use one-time passcodes generated by the Microsoft Authenticator app",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"temporary_access_pass": {
+				Description: "Settings for the `TemporaryAccessPass` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_length": {
+							Description:  "The default length in characters of a temporary access pass",
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(8, 48),
+						},
+
+						"default_lifetime_in_minutes": {
+							Description:  "The default lifetime in minutes of a temporary access pass",
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(10, 43200),
+						},
+
+						"maximum_lifetime_in_minutes": {
+							Description:  "The maximum lifetime in minutes of a temporary access pass",
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(10, 43200),
+						},
+
+						"minimum_lifetime_in_minutes": {
+							Description:  "The minimum lifetime in minutes of a temporary access pass",
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(10, 43200),
+						},
+
+						"usable_once": {
+							Description: "Whether a temporary access pass can only be used once",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"voice": {
+				Description: "Settings for the `Voice` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"office_phone_allowed": {
+							Description: "Whether users can register office phone numbers",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"x509_certificate": {
+				Description: "Settings for the `X509Certificate` method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_authentication_mode": {
+							Description:  "The default strength of authentication provided by certificates",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForX509CertificateAuthenticationMode(), false),
+						},
+
+						"authentication_mode_rule": {
+							Description: "Rules which override the default authentication mode for specific certificates",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"authentication_mode": {
+										Description:  "The strength of authentication provided by matching certificates",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForX509CertificateAuthenticationMode(), false),
+									},
+
+									"identifier": {
+										Description:  "The issuer subject or policy OID to match",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"rule_type": {
+										Description:  "The type of rule",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{string(stable.X509CertificateRuleType_IssuerSubject), string(stable.X509CertificateRuleType_PolicyOID)}, false),
+									},
+								},
+							},
+						},
+
+						"certificate_user_binding": {
+							Description: "Mappings of certificate fields to user properties, used to identify the user signing in",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"priority": {
+										Description:  "The priority of the binding",
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"user_property": {
+										Description:  "The user property to match",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"x509_certificate_field": {
+										Description:  "The certificate field to match",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationMethodFeatureConfigurationSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"state": {
+					Description:  "The state of the feature",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAdvancedConfigState(), false),
+				},
+
+				"include_target_id": {
+					Description:  "The object ID of the group for which the feature is enabled, or `all_users`",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      authenticationMethodAllUsers,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"exclude_target_id": {
+					Description:  "The object ID of the group for which the feature is disabled",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00000000-0000-0000-0000-000000000000",
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func authenticationMethodPolicyResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	method := diff.Get("method").(string)
+
+	settingsBlocks := map[string]string{
+		authenticationMethodEmail:                  "email",
+		authenticationMethodFido2:                  "fido2",
+		authenticationMethodMicrosoftAuthenticator: "microsoft_authenticator",
+		authenticationMethodTemporaryAccessPass:    "temporary_access_pass",
+		authenticationMethodVoice:                  "voice",
+		authenticationMethodX509Certificate:        "x509_certificate",
+	}
+	for m, block := range settingsBlocks {
+		if m != method && len(diff.Get(block).([]interface{})) > 0 {
+			return fmt.Errorf("the `%s` block can only be specified when `method` is %q", block, m)
+		}
+	}
+
+	for _, targetRaw := range diff.Get("include_target").(*pluginsdk.Set).List() {
+		target := targetRaw.(map[string]interface{})
+		if target["authentication_mode"].(string) != string(stable.MicrosoftAuthenticatorAuthenticationMode_Any) && method != authenticationMethodMicrosoftAuthenticator {
+			return fmt.Errorf("`authentication_mode` can only be specified for an `include_target` when `method` is %q", authenticationMethodMicrosoftAuthenticator)
+		}
+	}
+
+	return nil
+}
+
+func authenticationMethodPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	// Authentication method configurations always exist, so we update the existing configuration
+	id := stable.NewPolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(d.Get("method").(string))
+
+	if diags := authenticationMethodPolicyResourceApply(ctx, d, meta, id); diags != nil {
+		return diags
+	}
+
+	d.SetId(id.ID())
+
+	return authenticationMethodPolicyResourceRead(ctx, d, meta)
+}
+
+func authenticationMethodPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := stable.ParsePolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if diags := authenticationMethodPolicyResourceApply(ctx, d, meta, *id); diags != nil {
+		return diags
+	}
+
+	return authenticationMethodPolicyResourceRead(ctx, d, meta)
+}
+
+func authenticationMethodPolicyResourceApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.PolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodConfigurationClient
+
+	properties, err := expandAuthenticationMethodConfiguration(d, id.AuthenticationMethodConfigurationId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building request for %s", id)
+	}
+
+	if _, err = client.UpdateAuthenticationMethodsPolicyConfiguration(ctx, id, properties, authenticationmethodspolicyauthenticationmethodconfiguration.DefaultUpdateAuthenticationMethodsPolicyConfigurationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return nil
+}
+
+func authenticationMethodPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodConfigurationClient
+
+	id, err := stable.ParsePolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetAuthenticationMethodsPolicyConfiguration(ctx, *id, authenticationmethodspolicyauthenticationmethodconfiguration.DefaultGetAuthenticationMethodsPolicyConfigurationOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	base := resp.Model.AuthenticationMethodConfiguration()
+
+	emailSettings := make([]map[string]interface{}, 0)
+	fido2Settings := make([]map[string]interface{}, 0)
+	microsoftAuthenticatorSettings := make([]map[string]interface{}, 0)
+	temporaryAccessPassSettings := make([]map[string]interface{}, 0)
+	voiceSettings := make([]map[string]interface{}, 0)
+	x509CertificateSettings := make([]map[string]interface{}, 0)
+	includeTargets := make([]map[string]interface{}, 0)
+
+	switch configuration := resp.Model.(type) {
+	case stable.EmailAuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+		emailSettings = append(emailSettings, map[string]interface{}{
+			"allow_external_id_to_use_email_otp": string(pointer.From(configuration.AllowExternalIdToUseEmailOtp)),
+		})
+
+	case stable.Fido2AuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+		keyRestrictions := make([]map[string]interface{}, 0)
+		if restrictions := configuration.KeyRestrictions; restrictions != nil {
+			keyRestrictions = append(keyRestrictions, map[string]interface{}{
+				"aaguids":          tf.FlattenStringSlicePtr(restrictions.AaGuids),
+				"enforced":         restrictions.IsEnforced.GetOrZero(),
+				"enforcement_type": string(pointer.From(restrictions.EnforcementType)),
+			})
+		}
+		fido2Settings = append(fido2Settings, map[string]interface{}{
+			"attestation_enforced":              configuration.IsAttestationEnforced.GetOrZero(),
+			"key_restrictions":                  keyRestrictions,
+			"self_service_registration_allowed": configuration.IsSelfServiceRegistrationAllowed.GetOrZero(),
+		})
+
+	case stable.MicrosoftAuthenticatorAuthenticationMethodConfiguration:
+		if configuration.IncludeTargets != nil {
+			for _, target := range *configuration.IncludeTargets {
+				includeTargets = append(includeTargets, map[string]interface{}{
+					"authentication_mode":   string(pointer.From(target.AuthenticationMode)),
+					"id":                    pointer.From(target.Id),
+					"registration_required": pointer.From(target.IsRegistrationRequired),
+					"target_type":           string(pointer.From(target.TargetType)),
+					"usable_for_sign_in":    false,
+				})
+			}
+		}
+		settings := map[string]interface{}{
+			"display_app_information":      []map[string]interface{}{},
+			"display_location_information": []map[string]interface{}{},
+			"software_oath_enabled":        configuration.IsSoftwareOathEnabled.GetOrZero(),
+		}
+		if featureSettings := configuration.FeatureSettings; featureSettings != nil {
+			settings["display_app_information"] = flattenAuthenticationMethodFeatureConfiguration(featureSettings.DisplayAppInformationRequiredState)
+			settings["display_location_information"] = flattenAuthenticationMethodFeatureConfiguration(featureSettings.DisplayLocationInformationRequiredState)
+		}
+		microsoftAuthenticatorSettings = append(microsoftAuthenticatorSettings, settings)
+
+	case stable.SmsAuthenticationMethodConfiguration:
+		if configuration.IncludeTargets != nil {
+			for _, target := range *configuration.IncludeTargets {
+				includeTargets = append(includeTargets, map[string]interface{}{
+					"authentication_mode":   string(stable.MicrosoftAuthenticatorAuthenticationMode_Any),
+					"id":                    pointer.From(target.Id),
+					"registration_required": pointer.From(target.IsRegistrationRequired),
+					"target_type":           string(pointer.From(target.TargetType)),
+					"usable_for_sign_in":    pointer.From(target.IsUsableForSignIn),
+				})
+			}
+		}
+
+	case stable.SoftwareOathAuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+
+	case stable.TemporaryAccessPassAuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+		temporaryAccessPassSettings = append(temporaryAccessPassSettings, map[string]interface{}{
+			"default_length":              configuration.DefaultLength.GetOrZero(),
+			"default_lifetime_in_minutes": configuration.DefaultLifetimeInMinutes.GetOrZero(),
+			"maximum_lifetime_in_minutes": configuration.MaximumLifetimeInMinutes.GetOrZero(),
+			"minimum_lifetime_in_minutes": configuration.MinimumLifetimeInMinutes.GetOrZero(),
+			"usable_once":                 configuration.IsUsableOnce.GetOrZero(),
+		})
+
+	case stable.VoiceAuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+		voiceSettings = append(voiceSettings, map[string]interface{}{
+			"office_phone_allowed": configuration.IsOfficePhoneAllowed.GetOrZero(),
+		})
+
+	case stable.X509CertificateAuthenticationMethodConfiguration:
+		includeTargets = flattenAuthenticationMethodTargets(configuration.IncludeTargets)
+		settings := map[string]interface{}{
+			"authentication_mode_rule":    []map[string]interface{}{},
+			"certificate_user_binding":    []map[string]interface{}{},
+			"default_authentication_mode": "",
+		}
+		if modeConfiguration := configuration.AuthenticationModeConfiguration; modeConfiguration != nil {
+			settings["default_authentication_mode"] = string(pointer.From(modeConfiguration.X509CertificateAuthenticationDefaultMode))
+			rules := make([]map[string]interface{}, 0)
+			if modeConfiguration.Rules != nil {
+				for _, rule := range *modeConfiguration.Rules {
+					identifier := rule.Identifier.GetOrZero()
+					if rule.X509CertificateRuleType == stable.X509CertificateRuleType_PolicyOID && rule.PolicyOidIdentifier.GetOrZero() != "" {
+						identifier = rule.PolicyOidIdentifier.GetOrZero()
+					}
+					rules = append(rules, map[string]interface{}{
+						"authentication_mode": string(rule.X509CertificateAuthenticationMode),
+						"identifier":          identifier,
+						"rule_type":           string(rule.X509CertificateRuleType),
+					})
+				}
+			}
+			settings["authentication_mode_rule"] = rules
+		}
+		if configuration.CertificateUserBindings != nil {
+			bindings := make([]map[string]interface{}, 0)
+			for _, binding := range *configuration.CertificateUserBindings {
+				bindings = append(bindings, map[string]interface{}{
+					"priority":               pointer.From(binding.Priority),
+					"user_property":          binding.UserProperty.GetOrZero(),
+					"x509_certificate_field": binding.X509CertificateField.GetOrZero(),
+				})
+			}
+			settings["certificate_user_binding"] = bindings
+		}
+		x509CertificateSettings = append(x509CertificateSettings, settings)
+
+	default:
+		return tf.ErrorDiagF(fmt.Errorf("unsupported authentication method configuration type %T", resp.Model), "Retrieving %s", id)
+	}
+
+	excludeTargets := make([]map[string]interface{}, 0)
+	if base.ExcludeTargets != nil {
+		for _, target := range *base.ExcludeTargets {
+			excludeTargets = append(excludeTargets, map[string]interface{}{
+				"id":          pointer.From(target.Id),
+				"target_type": string(pointer.From(target.TargetType)),
+			})
+		}
+	}
+
+	tf.Set(d, "email", emailSettings)
+	tf.Set(d, "exclude_target", excludeTargets)
+	tf.Set(d, "fido2", fido2Settings)
+	tf.Set(d, "include_target", includeTargets)
+	tf.Set(d, "method", id.AuthenticationMethodConfigurationId)
+	tf.Set(d, "microsoft_authenticator", microsoftAuthenticatorSettings)
+	tf.Set(d, "state", string(pointer.From(base.State)))
+	tf.Set(d, "temporary_access_pass", temporaryAccessPassSettings)
+	tf.Set(d, "voice", voiceSettings)
+	tf.Set(d, "x509_certificate", x509CertificateSettings)
+
+	return nil
+}
+
+func authenticationMethodPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodConfigurationClient
+
+	id, err := stable.ParsePolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	// Authentication method configurations cannot be deleted, so instead we disable the authentication method
+	properties, err := newAuthenticationMethodConfiguration(id.AuthenticationMethodConfigurationId, stable.BaseAuthenticationMethodConfigurationImpl{
+		State: pointer.To(stable.AuthenticationMethodState_Disabled),
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building request for %s", id)
+	}
+
+	if _, err = client.UpdateAuthenticationMethodsPolicyConfiguration(ctx, *id, properties, authenticationmethodspolicyauthenticationmethodconfiguration.DefaultUpdateAuthenticationMethodsPolicyConfigurationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Disabling %s", id)
+	}
+
+	log.Printf("[DEBUG] %s has been disabled", id)
+
+	return nil
+}
+
+// newAuthenticationMethodConfiguration returns an empty configuration of the correct type for the specified method,
+// populated with the common properties from `base`
+func newAuthenticationMethodConfiguration(method string, base stable.BaseAuthenticationMethodConfigurationImpl) (stable.AuthenticationMethodConfiguration, error) {
+	switch method {
+	case authenticationMethodEmail:
+		return stable.EmailAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodFido2:
+		return stable.Fido2AuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodMicrosoftAuthenticator:
+		return stable.MicrosoftAuthenticatorAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodSms:
+		return stable.SmsAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodSoftwareOath:
+		return stable.SoftwareOathAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodTemporaryAccessPass:
+		return stable.TemporaryAccessPassAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodVoice:
+		return stable.VoiceAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	case authenticationMethodX509Certificate:
+		return stable.X509CertificateAuthenticationMethodConfiguration{ExcludeTargets: base.ExcludeTargets, State: base.State}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported authentication method %q", method)
+}
+
+func expandAuthenticationMethodConfiguration(d *pluginsdk.ResourceData, method string) (stable.AuthenticationMethodConfiguration, error) {
+	excludeTargets := make([]stable.ExcludeTarget, 0)
+	for _, targetRaw := range d.Get("exclude_target").(*pluginsdk.Set).List() {
+		target := targetRaw.(map[string]interface{})
+		excludeTargets = append(excludeTargets, stable.ExcludeTarget{
+			Id:         pointer.To(target["id"].(string)),
+			TargetType: pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+		})
+	}
+
+	base := stable.BaseAuthenticationMethodConfigurationImpl{
+		ExcludeTargets: &excludeTargets,
+		State:          pointer.To(stable.AuthenticationMethodState(d.Get("state").(string))),
+	}
+
+	configuration, err := newAuthenticationMethodConfiguration(method, base)
+	if err != nil {
+		return nil, err
+	}
+
+	// Include targets and method settings are only sent when specified, so that any existing values are retained
+	includeTargetsRaw, includeTargetsOk := d.GetOk("include_target")
+	var includeTargets []interface{}
+	if includeTargetsOk {
+		includeTargets = includeTargetsRaw.(*pluginsdk.Set).List()
+	}
+
+	switch c := configuration.(type) {
+	case stable.EmailAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		if v, ok := d.GetOk("email.0.allow_external_id_to_use_email_otp"); ok {
+			c.AllowExternalIdToUseEmailOtp = pointer.To(stable.ExternalEmailOtpState(v.(string)))
+		}
+		return c, nil
+
+	case stable.Fido2AuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		if len(d.Get("fido2").([]interface{})) > 0 {
+			if v, ok := d.GetOkExists("fido2.0.attestation_enforced"); ok { //nolint:staticcheck // needed to detect unset booleans
+				c.IsAttestationEnforced = nullable.Value(v.(bool))
+			}
+			if v, ok := d.GetOkExists("fido2.0.self_service_registration_allowed"); ok { //nolint:staticcheck // needed to detect unset booleans
+				c.IsSelfServiceRegistrationAllowed = nullable.Value(v.(bool))
+			}
+			if len(d.Get("fido2.0.key_restrictions").([]interface{})) > 0 {
+				c.KeyRestrictions = &stable.Fido2KeyRestrictions{
+					AaGuids:         tf.ExpandStringSlicePtr(d.Get("fido2.0.key_restrictions.0.aaguids").(*pluginsdk.Set).List()),
+					EnforcementType: pointer.To(stable.Fido2RestrictionEnforcementType(d.Get("fido2.0.key_restrictions.0.enforcement_type").(string))),
+					IsEnforced:      nullable.Value(d.Get("fido2.0.key_restrictions.0.enforced").(bool)),
+				}
+			}
+		}
+		return c, nil
+
+	case stable.MicrosoftAuthenticatorAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			targets := make([]stable.MicrosoftAuthenticatorAuthenticationMethodTarget, 0)
+			for _, targetRaw := range includeTargets {
+				target := targetRaw.(map[string]interface{})
+				targets = append(targets, stable.MicrosoftAuthenticatorAuthenticationMethodTarget{
+					AuthenticationMode:     pointer.To(stable.MicrosoftAuthenticatorAuthenticationMode(target["authentication_mode"].(string))),
+					Id:                     pointer.To(target["id"].(string)),
+					IsRegistrationRequired: pointer.To(target["registration_required"].(bool)),
+					TargetType:             pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+				})
+			}
+			c.IncludeTargets = &targets
+		}
+		if len(d.Get("microsoft_authenticator").([]interface{})) > 0 {
+			if v, ok := d.GetOkExists("microsoft_authenticator.0.software_oath_enabled"); ok { //nolint:staticcheck // needed to detect unset booleans
+				c.IsSoftwareOathEnabled = nullable.Value(v.(bool))
+			}
+			appInformation := d.Get("microsoft_authenticator.0.display_app_information").([]interface{})
+			locationInformation := d.Get("microsoft_authenticator.0.display_location_information").([]interface{})
+			if len(appInformation) > 0 || len(locationInformation) > 0 {
+				c.FeatureSettings = &stable.MicrosoftAuthenticatorFeatureSettings{
+					DisplayAppInformationRequiredState:      expandAuthenticationMethodFeatureConfiguration(appInformation),
+					DisplayLocationInformationRequiredState: expandAuthenticationMethodFeatureConfiguration(locationInformation),
+				}
+			}
+		}
+		return c, nil
+
+	case stable.SmsAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			targets := make([]stable.SmsAuthenticationMethodTarget, 0)
+			for _, targetRaw := range includeTargets {
+				target := targetRaw.(map[string]interface{})
+				targets = append(targets, stable.SmsAuthenticationMethodTarget{
+					Id:                     pointer.To(target["id"].(string)),
+					IsRegistrationRequired: pointer.To(target["registration_required"].(bool)),
+					IsUsableForSignIn:      pointer.To(target["usable_for_sign_in"].(bool)),
+					TargetType:             pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+				})
+			}
+			c.IncludeTargets = &targets
+		}
+		return c, nil
+
+	case stable.SoftwareOathAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		return c, nil
+
+	case stable.TemporaryAccessPassAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		if v, ok := d.GetOk("temporary_access_pass.0.default_length"); ok {
+			c.DefaultLength = nullable.Value(int64(v.(int)))
+		}
+		if v, ok := d.GetOk("temporary_access_pass.0.default_lifetime_in_minutes"); ok {
+			c.DefaultLifetimeInMinutes = nullable.Value(int64(v.(int)))
+		}
+		if v, ok := d.GetOk("temporary_access_pass.0.maximum_lifetime_in_minutes"); ok {
+			c.MaximumLifetimeInMinutes = nullable.Value(int64(v.(int)))
+		}
+		if v, ok := d.GetOk("temporary_access_pass.0.minimum_lifetime_in_minutes"); ok {
+			c.MinimumLifetimeInMinutes = nullable.Value(int64(v.(int)))
+		}
+		if v, ok := d.GetOkExists("temporary_access_pass.0.usable_once"); ok { //nolint:staticcheck // needed to detect unset booleans
+			c.IsUsableOnce = nullable.Value(v.(bool))
+		}
+		return c, nil
+
+	case stable.VoiceAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		if v, ok := d.GetOkExists("voice.0.office_phone_allowed"); ok { //nolint:staticcheck // needed to detect unset booleans
+			c.IsOfficePhoneAllowed = nullable.Value(v.(bool))
+		}
+		return c, nil
+
+	case stable.X509CertificateAuthenticationMethodConfiguration:
+		if includeTargetsOk {
+			c.IncludeTargets = expandAuthenticationMethodTargets(includeTargets)
+		}
+		if len(d.Get("x509_certificate").([]interface{})) > 0 {
+			if v, ok := d.GetOk("x509_certificate.0.default_authentication_mode"); ok {
+				rules := make([]stable.X509CertificateRule, 0)
+				for _, ruleRaw := range d.Get("x509_certificate.0.authentication_mode_rule").([]interface{}) {
+					rule := ruleRaw.(map[string]interface{})
+					ruleType := stable.X509CertificateRuleType(rule["rule_type"].(string))
+					certificateRule := stable.X509CertificateRule{
+						X509CertificateAuthenticationMode: stable.X509CertificateAuthenticationMode(rule["authentication_mode"].(string)),
+						X509CertificateRuleType:           ruleType,
+					}
+					if ruleType == stable.X509CertificateRuleType_PolicyOID {
+						certificateRule.PolicyOidIdentifier = nullable.Value(rule["identifier"].(string))
+					} else {
+						certificateRule.Identifier = nullable.Value(rule["identifier"].(string))
+					}
+					rules = append(rules, certificateRule)
+				}
+				c.AuthenticationModeConfiguration = &stable.X509CertificateAuthenticationModeConfiguration{
+					Rules:                                    &rules,
+					X509CertificateAuthenticationDefaultMode: pointer.To(stable.X509CertificateAuthenticationMode(v.(string))),
+				}
+			}
+			if v, ok := d.GetOk("x509_certificate.0.certificate_user_binding"); ok {
+				bindings := make([]stable.X509CertificateUserBinding, 0)
+				for _, bindingRaw := range v.([]interface{}) {
+					binding := bindingRaw.(map[string]interface{})
+					bindings = append(bindings, stable.X509CertificateUserBinding{
+						Priority:             pointer.To(int64(binding["priority"].(int))),
+						UserProperty:         nullable.Value(binding["user_property"].(string)),
+						X509CertificateField: nullable.Value(binding["x509_certificate_field"].(string)),
+					})
+				}
+				c.CertificateUserBindings = &bindings
+			}
+		}
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("unsupported authentication method %q", method)
+}
+
+func expandAuthenticationMethodTargets(in []interface{}) *[]stable.AuthenticationMethodTarget {
+	result := make([]stable.AuthenticationMethodTarget, 0)
+	for _, targetRaw := range in {
+		target := targetRaw.(map[string]interface{})
+		result = append(result, stable.BaseAuthenticationMethodTargetImpl{
+			Id:                     pointer.To(target["id"].(string)),
+			IsRegistrationRequired: pointer.To(target["registration_required"].(bool)),
+			TargetType:             pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+		})
+	}
+	return &result
+}
+
+func flattenAuthenticationMethodTargets(in *[]stable.AuthenticationMethodTarget) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, t := range *in {
+		target := t.AuthenticationMethodTarget()
+		result = append(result, map[string]interface{}{
+			"authentication_mode":   string(stable.MicrosoftAuthenticatorAuthenticationMode_Any),
+			"id":                    pointer.From(target.Id),
+			"registration_required": pointer.From(target.IsRegistrationRequired),
+			"target_type":           string(pointer.From(target.TargetType)),
+			"usable_for_sign_in":    false,
+		})
+	}
+
+	return result
+}
+
+func expandAuthenticationMethodFeatureConfiguration(in []interface{}) *stable.AuthenticationMethodFeatureConfiguration {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return &stable.AuthenticationMethodFeatureConfiguration{
+		ExcludeTarget: &stable.FeatureTarget{
+			Id:         nullable.Value(config["exclude_target_id"].(string)),
+			TargetType: pointer.To(stable.FeatureTargetType_Group),
+		},
+		IncludeTarget: &stable.FeatureTarget{
+			Id:         nullable.Value(config["include_target_id"].(string)),
+			TargetType: pointer.To(stable.FeatureTargetType_Group),
+		},
+		State: pointer.To(stable.AdvancedConfigState(config["state"].(string))),
+	}
+}
+
+func flattenAuthenticationMethodFeatureConfiguration(in *stable.AuthenticationMethodFeatureConfiguration) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"exclude_target_id": "",
+		"include_target_id": "",
+		"state":             string(pointer.From(in.State)),
+	}
+	if in.ExcludeTarget != nil {
+		result["exclude_target_id"] = in.ExcludeTarget.Id.GetOrZero()
+	}
+	if in.IncludeTarget != nil {
+		result["include_target_id"] = in.IncludeTarget.Id.GetOrZero()
+	}
+
+	return []map[string]interface{}{result}
+}
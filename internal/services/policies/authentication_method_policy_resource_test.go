@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicyauthenticationmethodconfiguration"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationMethodPolicyResource struct{}
+
+func TestAccAuthenticationMethodPolicy_fido2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_policy", "test")
+	r := AuthenticationMethodPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fido2(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabled"),
+				check.That(data.ResourceName).Key("fido2.0.key_restrictions.0.aaguids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("exclude_target.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationMethodPolicy_microsoftAuthenticator(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_policy", "test")
+	r := AuthenticationMethodPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.microsoftAuthenticator(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("include_target.#").HasValue("1"),
+				check.That(data.ResourceName).Key("microsoft_authenticator.0.display_app_information.0.state").HasValue("enabled"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationMethodPolicy_temporaryAccessPassUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_policy", "test")
+	r := AuthenticationMethodPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.temporaryAccessPass(data, 60),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("temporary_access_pass.0.default_lifetime_in_minutes").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.temporaryAccessPass(data, 120),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("temporary_access_pass.0.default_lifetime_in_minutes").HasValue("120"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationMethodPolicy_sms(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_policy", "test")
+	r := AuthenticationMethodPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sms(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("include_target.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+// Exists returns false when the authentication method has been disabled, since configurations cannot be deleted
+func (r AuthenticationMethodPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthenticationMethodConfigurationClient
+
+	id, err := stable.ParsePolicyAuthenticationMethodsPolicyAuthenticationMethodConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAuthenticationMethodsPolicyConfiguration(ctx, *id, authenticationmethodspolicyauthenticationmethodconfiguration.DefaultGetAuthenticationMethodsPolicyConfigurationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	if resp.Model == nil {
+		return pointer.To(false), nil
+	}
+
+	methodState := resp.Model.AuthenticationMethodConfiguration().State
+	return pointer.To(pointer.From(methodState) == stable.AuthenticationMethodState_Enabled), nil
+}
+
+func (AuthenticationMethodPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestAuthMethod-%[1]s"
+  mail_enabled     = false
+  security_enabled = true
+}
+`, data.RandomString)
+}
+
+func (r AuthenticationMethodPolicyResource) fido2(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_authentication_method_policy" "test" {
+  method = "Fido2"
+
+  exclude_target {
+    id = azuread_group.test.object_id
+  }
+
+  fido2 {
+    attestation_enforced              = false
+    self_service_registration_allowed = true
+
+    key_restrictions {
+      aaguids          = ["cb69481e-8ff7-4039-93ec-0a2729a154a8"]
+      enforced         = true
+      enforcement_type = "allow"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r AuthenticationMethodPolicyResource) microsoftAuthenticator(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_authentication_method_policy" "test" {
+  method = "MicrosoftAuthenticator"
+
+  include_target {
+    id                  = azuread_group.test.object_id
+    authentication_mode = "push"
+  }
+
+  microsoft_authenticator {
+    software_oath_enabled = false
+
+    display_app_information {
+      state = "enabled"
+    }
+
+    display_location_information {
+      state             = "enabled"
+      include_target_id = azuread_group.test.object_id
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r AuthenticationMethodPolicyResource) temporaryAccessPass(data acceptance.TestData, lifetime int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_authentication_method_policy" "test" {
+  method = "TemporaryAccessPass"
+
+  include_target {
+    id = azuread_group.test.object_id
+  }
+
+  temporary_access_pass {
+    default_length              = 12
+    default_lifetime_in_minutes = %[2]d
+    maximum_lifetime_in_minutes = 480
+    minimum_lifetime_in_minutes = 60
+    usable_once                 = true
+  }
+}
+`, r.template(data), lifetime)
+}
+
+func (r AuthenticationMethodPolicyResource) sms(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_authentication_method_policy" "test" {
+  method = "Sms"
+
+  include_target {
+    id                 = azuread_group.test.object_id
+    usable_for_sign_in = false
+  }
+}
+`, r.template(data))
+}
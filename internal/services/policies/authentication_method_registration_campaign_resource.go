@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// authenticationMethodsPolicyId is the ID of the tenant authentication methods policy, which always exists in a tenant
+const authenticationMethodsPolicyId = "/policies/authenticationMethodsPolicy"
+
+func authenticationMethodRegistrationCampaignResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationMethodRegistrationCampaignResourceCreateUpdate,
+		ReadContext:   authenticationMethodRegistrationCampaignResourceRead,
+		UpdateContext: authenticationMethodRegistrationCampaignResourceCreateUpdate,
+		DeleteContext: authenticationMethodRegistrationCampaignResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != authenticationMethodsPolicyId {
+				return fmt.Errorf("expected ID to be %q, got %q", authenticationMethodsPolicyId, id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"state": {
+				Description:  "The state of the registration campaign",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(stable.AdvancedConfigState_Enabled),
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAdvancedConfigState(), false),
+			},
+
+			"snooze_duration_in_days": {
+				Description:  "The number of days before a user who snoozes the registration prompt is prompted again",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 14),
+			},
+
+			"include_target": {
+				Description: "The users or groups who are prompted to register an authentication method",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:  "The object ID of the group or user, or `all_users`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_type": {
+							Description:  "The type of the target",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.AuthenticationMethodTargetType_Group),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationMethodTargetType(), false),
+						},
+
+						"targeted_authentication_method": {
+							Description:  "The authentication method that users are prompted to register",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "microsoftAuthenticator",
+							ValidateFunc: validation.StringInSlice([]string{"microsoftAuthenticator"}, false),
+						},
+					},
+				},
+			},
+
+			"exclude_target": {
+				Description: "The users or groups who are not prompted to register an authentication method",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:  "The object ID of the group or user",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"target_type": {
+							Description:  "The type of the target",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.AuthenticationMethodTargetType_Group),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationMethodTargetType(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationMethodRegistrationCampaignResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	excludeTargets := make([]stable.ExcludeTarget, 0)
+	for _, targetRaw := range d.Get("exclude_target").(*pluginsdk.Set).List() {
+		target := targetRaw.(map[string]interface{})
+		excludeTargets = append(excludeTargets, stable.ExcludeTarget{
+			Id:         pointer.To(target["id"].(string)),
+			TargetType: pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+		})
+	}
+
+	campaign := stable.AuthenticationMethodsRegistrationCampaign{
+		ExcludeTargets:       &excludeTargets,
+		SnoozeDurationInDays: pointer.To(int64(d.Get("snooze_duration_in_days").(int))),
+		State:                pointer.To(stable.AdvancedConfigState(d.Get("state").(string))),
+	}
+
+	// Include targets are only sent when specified, so that the service default of all users is retained
+	if v, ok := d.GetOk("include_target"); ok {
+		includeTargets := make([]stable.AuthenticationMethodsRegistrationCampaignIncludeTarget, 0)
+		for _, targetRaw := range v.(*pluginsdk.Set).List() {
+			target := targetRaw.(map[string]interface{})
+			includeTargets = append(includeTargets, stable.AuthenticationMethodsRegistrationCampaignIncludeTarget{
+				Id:                           pointer.To(target["id"].(string)),
+				TargetType:                   pointer.To(stable.AuthenticationMethodTargetType(target["target_type"].(string))),
+				TargetedAuthenticationMethod: nullable.Value(target["targeted_authentication_method"].(string)),
+			})
+		}
+		campaign.IncludeTargets = &includeTargets
+	}
+
+	properties := stable.AuthenticationMethodsPolicy{
+		RegistrationEnforcement: &stable.RegistrationEnforcement{
+			AuthenticationMethodsRegistrationCampaign: &campaign,
+		},
+	}
+
+	if _, err := client.UpdateAuthenticationMethodsPolicy(ctx, properties, authenticationmethodspolicy.DefaultUpdateAuthenticationMethodsPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update authentication methods registration campaign")
+	}
+
+	d.SetId(authenticationMethodsPolicyId)
+
+	return authenticationMethodRegistrationCampaignResourceRead(ctx, d, meta)
+}
+
+func authenticationMethodRegistrationCampaignResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	resp, err := client.GetAuthenticationMethodsPolicy(ctx, authenticationmethodspolicy.DefaultGetAuthenticationMethodsPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authentication methods policy")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving authentication methods policy")
+	}
+
+	var campaign stable.AuthenticationMethodsRegistrationCampaign
+	if policy.RegistrationEnforcement != nil && policy.RegistrationEnforcement.AuthenticationMethodsRegistrationCampaign != nil {
+		campaign = *policy.RegistrationEnforcement.AuthenticationMethodsRegistrationCampaign
+	}
+
+	includeTargets := make([]map[string]interface{}, 0)
+	if campaign.IncludeTargets != nil {
+		for _, target := range *campaign.IncludeTargets {
+			includeTargets = append(includeTargets, map[string]interface{}{
+				"id":                             pointer.From(target.Id),
+				"target_type":                    string(pointer.From(target.TargetType)),
+				"targeted_authentication_method": target.TargetedAuthenticationMethod.GetOrZero(),
+			})
+		}
+	}
+
+	excludeTargets := make([]map[string]interface{}, 0)
+	if campaign.ExcludeTargets != nil {
+		for _, target := range *campaign.ExcludeTargets {
+			excludeTargets = append(excludeTargets, map[string]interface{}{
+				"id":          pointer.From(target.Id),
+				"target_type": string(pointer.From(target.TargetType)),
+			})
+		}
+	}
+
+	tf.Set(d, "exclude_target", excludeTargets)
+	tf.Set(d, "include_target", includeTargets)
+	tf.Set(d, "snooze_duration_in_days", int(pointer.From(campaign.SnoozeDurationInDays)))
+	tf.Set(d, "state", string(pointer.From(campaign.State)))
+
+	return nil
+}
+
+func authenticationMethodRegistrationCampaignResourceDelete(ctx context.Context, _ *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	// The registration campaign cannot be deleted, so instead we return it to the Microsoft managed state with no exclusions
+	properties := stable.AuthenticationMethodsPolicy{
+		RegistrationEnforcement: &stable.RegistrationEnforcement{
+			AuthenticationMethodsRegistrationCampaign: &stable.AuthenticationMethodsRegistrationCampaign{
+				ExcludeTargets: &[]stable.ExcludeTarget{},
+				State:          pointer.To(stable.AdvancedConfigState_Default),
+			},
+		},
+	}
+
+	if _, err := client.UpdateAuthenticationMethodsPolicy(ctx, properties, authenticationmethodspolicy.DefaultUpdateAuthenticationMethodsPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Resetting authentication methods registration campaign")
+	}
+
+	return nil
+}
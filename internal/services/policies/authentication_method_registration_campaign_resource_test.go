@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationMethodRegistrationCampaignResource struct{}
+
+func TestAccAuthenticationMethodRegistrationCampaign_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_registration_campaign", "test")
+	r := AuthenticationMethodRegistrationCampaignResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabled"),
+				check.That(data.ResourceName).Key("include_target.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationMethodRegistrationCampaign_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_registration_campaign", "test")
+	r := AuthenticationMethodRegistrationCampaignResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snooze_duration_in_days").HasValue("3"),
+				check.That(data.ResourceName).Key("exclude_target.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exclude_target.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthenticationMethodRegistrationCampaignResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthenticationMethodsPolicyClient
+
+	resp, err := client.GetAuthenticationMethodsPolicy(ctx, authenticationmethodspolicy.DefaultGetAuthenticationMethodsPolicyOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve authentication methods policy: %v", err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.RegistrationEnforcement != nil), nil
+}
+
+func (AuthenticationMethodRegistrationCampaignResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authentication_method_registration_campaign" "test" {
+  state = "enabled"
+
+  include_target {
+    id          = "all_users"
+    target_type = "group"
+  }
+}
+`
+}
+
+func (AuthenticationMethodRegistrationCampaignResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestRegistrationCampaign-%[1]s"
+  mail_enabled     = false
+  security_enabled = true
+}
+
+resource "azuread_authentication_method_registration_campaign" "test" {
+  state                   = "enabled"
+  snooze_duration_in_days = 3
+
+  include_target {
+    id                             = "all_users"
+    target_type                    = "group"
+    targeted_authentication_method = "microsoftAuthenticator"
+  }
+
+  exclude_target {
+    id          = azuread_group.test.object_id
+    target_type = "group"
+  }
+}
+`, data.RandomString)
+}
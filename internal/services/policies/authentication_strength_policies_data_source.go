@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationstrengthauthenticationmethodmode"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func authenticationStrengthPoliciesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: authenticationStrengthPoliciesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"policy_type": {
+				Description:  "Only return authentication strength policies of this type",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationStrengthPolicyType(), false),
+			},
+
+			"policies": {
+				Description: "A list of authentication strength policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the authentication strength policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the authentication strength policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "The description of the authentication strength policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"policy_type": {
+							Description: "Whether the authentication strength policy is built in or custom",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"requirements_satisfied": {
+							Description: "The authentication requirements satisfied by the authentication strength policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"allowed_combinations": {
+							Description: "The authentication method combinations allowed by the authentication strength policy",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"authentication_method_modes": {
+				Description: "A list of the authentication method modes which can be combined in authentication strength policies",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The identifier of the authentication method mode, for use in `allowed_combinations`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the authentication method mode",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationStrengthPoliciesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+	methodModeClient := meta.(*clients.Client).Policies.AuthenticationMethodModeClient
+
+	policyType := d.Get("policy_type").(string)
+
+	options := authenticationstrengthpolicy.ListAuthenticationStrengthPoliciesOperationOptions{}
+	if policyType != "" {
+		options.Filter = pointer.To(fmt.Sprintf("policyType eq '%s'", odata.EscapeSingleQuote(policyType)))
+	}
+
+	resp, err := client.ListAuthenticationStrengthPolicies(ctx, options)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authentication strength policies")
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not retrieve authentication strength policies")
+	}
+
+	policyIds := make([]string, 0)
+	policies := make([]interface{}, 0)
+
+	for _, policy := range *resp.Model {
+		if policy.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned authentication strength policy with nil ID"), "Bad API Response")
+		}
+
+		allowedCombinations := make([]string, 0)
+		for _, v := range pointer.From(policy.AllowedCombinations) {
+			allowedCombinations = append(allowedCombinations, string(v))
+		}
+
+		id := stable.NewPolicyAuthenticationStrengthPolicyID(*policy.Id)
+		policyIds = append(policyIds, id.ID())
+		policies = append(policies, map[string]interface{}{
+			"id":                     id.ID(),
+			"display_name":           pointer.From(policy.DisplayName),
+			"description":            policy.Description.GetOrZero(),
+			"policy_type":            string(pointer.From(policy.PolicyType)),
+			"requirements_satisfied": string(pointer.From(policy.RequirementsSatisfied)),
+			"allowed_combinations":   allowedCombinations,
+		})
+	}
+
+	modesResp, err := methodModeClient.ListConditionalAccessAuthenticationStrengthAuthenticationMethodModes(ctx, conditionalaccessauthenticationstrengthauthenticationmethodmode.DefaultListConditionalAccessAuthenticationStrengthAuthenticationMethodModesOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authentication method modes")
+	}
+
+	methodModes := make([]interface{}, 0)
+	for _, mode := range pointer.From(modesResp.Model) {
+		methodModes = append(methodModes, map[string]interface{}{
+			"id":           pointer.From(mode.Id),
+			"display_name": pointer.From(mode.DisplayName),
+		})
+	}
+
+	h := sha1.New()
+	if _, err = h.Write([]byte(policyType + "/" + strings.Join(policyIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for authentication strength policy IDs")
+	}
+
+	d.SetId("authenticationStrengthPolicies#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "authentication_method_modes", methodModes)
+	tf.Set(d, "policies", policies)
+
+	return nil
+}
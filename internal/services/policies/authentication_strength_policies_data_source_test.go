@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AuthenticationStrengthPoliciesDataSource struct{}
+
+func TestAccAuthenticationStrengthPoliciesDataSource_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_authentication_strength_policies", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AuthenticationStrengthPoliciesDataSource{}.builtIn(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policies.#").Exists(),
+				check.That(data.ResourceName).Key("policies.0.policy_type").HasValue("builtIn"),
+				check.That(data.ResourceName).Key("policies.0.allowed_combinations.#").Exists(),
+				check.That(data.ResourceName).Key("authentication_method_modes.#").Exists(),
+			),
+		},
+	})
+}
+
+func (AuthenticationStrengthPoliciesDataSource) builtIn() string {
+	return `
+provider "azuread" {}
+
+data "azuread_authentication_strength_policies" "test" {
+  policy_type = "builtIn"
+}
+`
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicycombinationconfiguration"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
@@ -33,6 +34,8 @@ func authenticationStrengthPolicyResource() *pluginsdk.Resource {
 		UpdateContext: authenticationStrengthPolicyUpdate,
 		DeleteContext: authenticationStrengthPolicyDelete,
 
+		CustomizeDiff: authenticationStrengthPolicyCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -95,12 +98,116 @@ func authenticationStrengthPolicyResource() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"fido2_combination_configuration": {
+				Description: "Restricts the FIDO2 security keys which satisfy the `fido2` combination",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_aaguids": {
+							Description: "The authenticator attestation GUIDs (AAGUIDs) of the FIDO2 security keys which are allowed",
+							Type:        pluginsdk.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+					},
+				},
+			},
+
+			"x509_certificate_combination_configuration": {
+				Description: "Restricts the certificates which satisfy the `x509CertificateMultiFactor` and `x509CertificateSingleFactor` combinations",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"applies_to_combinations": {
+							Description: "The certificate combinations to which this configuration applies",
+							Type:        pluginsdk.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(stable.AuthenticationMethodModes_X509CertificateMultiFactor),
+									string(stable.AuthenticationMethodModes_X509CertificateSingleFactor),
+								}, false),
+							},
+						},
+
+						"allowed_issuer_skis": {
+							Description: "The subject key identifiers (SKIs) of the certificate issuers which are allowed",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_policy_oids": {
+							Description: "The certificate policy OIDs which are allowed",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func authenticationStrengthPolicyCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("allowed_combinations") {
+		return nil
+	}
+
+	allowedCombinations := make([]string, 0)
+	for _, v := range diff.Get("allowed_combinations").(*pluginsdk.Set).List() {
+		allowedCombinations = append(allowedCombinations, v.(string))
+	}
+
+	if len(diff.Get("fido2_combination_configuration").([]interface{})) > 0 && !slices.Contains(allowedCombinations, string(stable.AuthenticationMethodModes_Fido2)) {
+		return fmt.Errorf("`fido2_combination_configuration` can only be specified when `allowed_combinations` contains %q", stable.AuthenticationMethodModes_Fido2)
+	}
+
+	seen := make(map[string]bool)
+	for _, configurationRaw := range diff.Get("x509_certificate_combination_configuration").([]interface{}) {
+		if configurationRaw == nil {
+			continue
+		}
+		configuration := configurationRaw.(map[string]interface{})
+		if configuration["allowed_issuer_skis"].(*pluginsdk.Set).Len() == 0 && configuration["allowed_policy_oids"].(*pluginsdk.Set).Len() == 0 {
+			return errors.New("at least one of `allowed_issuer_skis` or `allowed_policy_oids` must be specified in each `x509_certificate_combination_configuration` block")
+		}
+		for _, v := range configuration["applies_to_combinations"].(*pluginsdk.Set).List() {
+			combination := v.(string)
+			if !slices.Contains(allowedCombinations, combination) {
+				return fmt.Errorf("`x509_certificate_combination_configuration` applies to %q, which must also be specified in `allowed_combinations`", combination)
+			}
+			if seen[combination] {
+				return fmt.Errorf("%q is specified in more than one `x509_certificate_combination_configuration` block", combination)
+			}
+			seen[combination] = true
+		}
+	}
+
+	return nil
+}
+
 func authenticationStrengthPolicyCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+	combinationConfigurationClient := meta.(*clients.Client).Policies.AuthenticationStrengthCombinationConfigurationClient
 
 	allowedCombinations := make([]stable.AuthenticationMethodModes, 0)
 	for _, v := range d.Get("allowed_combinations").(*pluginsdk.Set).List() {
@@ -144,11 +251,16 @@ func authenticationStrengthPolicyCreate(ctx context.Context, d *pluginsdk.Resour
 
 	d.SetId(id.ID())
 
+	if err = createAuthenticationStrengthPolicyCombinationConfigurations(ctx, combinationConfigurationClient, id, expandAuthenticationStrengthPolicyCombinationConfigurations(d)); err != nil {
+		return tf.ErrorDiagF(err, "Could not create combination configurations for %s", id)
+	}
+
 	return authenticationStrengthPolicyRead(ctx, d, meta)
 }
 
 func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+	combinationConfigurationClient := meta.(*clients.Client).Policies.AuthenticationStrengthCombinationConfigurationClient
 
 	id, err := stable.ParsePolicyAuthenticationStrengthPolicyID(d.Id())
 	if err != nil {
@@ -164,17 +276,33 @@ func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.Resour
 		return tf.ErrorDiagF(err, "Could not update %s", id)
 	}
 
-	if d.HasChange("allowed_combinations") {
-		allowedCombinations := make([]stable.AuthenticationMethodModes, 0)
-		for _, v := range d.Get("allowed_combinations").(*pluginsdk.Set).List() {
-			allowedCombinations = append(allowedCombinations, stable.AuthenticationMethodModes(v.(string)))
+	// Combination configurations must refer to allowed combinations, so any newly allowed combinations are added before
+	// the combination configurations are updated, and any combinations no longer allowed are removed afterwards
+	oldAllowedCombinationsRaw, newAllowedCombinationsRaw := d.GetChange("allowed_combinations")
+	oldAllowedCombinations := tf.ExpandStringSlice(oldAllowedCombinationsRaw.(*pluginsdk.Set).List())
+	newAllowedCombinations := tf.ExpandStringSlice(newAllowedCombinationsRaw.(*pluginsdk.Set).List())
+
+	intermediateAllowedCombinations := slices.Clone(oldAllowedCombinations)
+	for _, v := range newAllowedCombinations {
+		if !slices.Contains(intermediateAllowedCombinations, v) {
+			intermediateAllowedCombinations = append(intermediateAllowedCombinations, v)
 		}
+	}
 
-		request := authenticationstrengthpolicy.UpdateAuthenticationStrengthPolicyAllowedCombinationsRequest{
-			AllowedCombinations: pointer.To(allowedCombinations),
+	if len(intermediateAllowedCombinations) > len(oldAllowedCombinations) {
+		if err = updateAuthenticationStrengthPolicyAllowedCombinations(ctx, client, *id, intermediateAllowedCombinations); err != nil {
+			return tf.ErrorDiagF(err, "Could not update allowed combinations for %s", id)
+		}
+	}
+
+	if d.HasChanges("allowed_combinations", "fido2_combination_configuration", "x509_certificate_combination_configuration") {
+		if err = updateAuthenticationStrengthPolicyCombinationConfigurations(ctx, combinationConfigurationClient, *id, expandAuthenticationStrengthPolicyCombinationConfigurations(d)); err != nil {
+			return tf.ErrorDiagF(err, "Could not update combination configurations for %s", id)
 		}
+	}
 
-		if _, err := client.UpdateAuthenticationStrengthPolicyAllowedCombinations(ctx, *id, request, authenticationstrengthpolicy.DefaultUpdateAuthenticationStrengthPolicyAllowedCombinationsOperationOptions()); err != nil {
+	if len(intermediateAllowedCombinations) > len(newAllowedCombinations) {
+		if err = updateAuthenticationStrengthPolicyAllowedCombinations(ctx, client, *id, newAllowedCombinations); err != nil {
 			return tf.ErrorDiagF(err, "Could not update allowed combinations for %s", id)
 		}
 	}
@@ -184,6 +312,7 @@ func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.Resour
 
 func authenticationStrengthPolicyRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+	combinationConfigurationClient := meta.(*clients.Client).Policies.AuthenticationStrengthCombinationConfigurationClient
 
 	id, err := stable.ParsePolicyAuthenticationStrengthPolicyID(d.Id())
 	if err != nil {
@@ -212,6 +341,35 @@ func authenticationStrengthPolicyRead(ctx context.Context, d *pluginsdk.Resource
 	}
 	tf.Set(d, "allowed_combinations", tf.FlattenStringSlice(allowedCombinations))
 
+	combinationConfigurationsResp, err := combinationConfigurationClient.ListAuthenticationStrengthPolicyCombinationConfigurations(ctx, *id, authenticationstrengthpolicycombinationconfiguration.DefaultListAuthenticationStrengthPolicyCombinationConfigurationsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving combination configurations for %s", id)
+	}
+
+	fido2CombinationConfigurations := make([]map[string]interface{}, 0)
+	x509CertificateCombinationConfigurations := make([]map[string]interface{}, 0)
+	for _, c := range pointer.From(combinationConfigurationsResp.Model) {
+		switch configuration := c.(type) {
+		case stable.Fido2CombinationConfiguration:
+			fido2CombinationConfigurations = append(fido2CombinationConfigurations, map[string]interface{}{
+				"allowed_aaguids": tf.FlattenStringSlicePtr(configuration.AllowedAAGUIDs),
+			})
+		case stable.X509CertificateCombinationConfiguration:
+			appliesToCombinations := make([]string, 0)
+			for _, v := range pointer.From(configuration.AppliesToCombinations) {
+				appliesToCombinations = append(appliesToCombinations, string(v))
+			}
+			x509CertificateCombinationConfigurations = append(x509CertificateCombinationConfigurations, map[string]interface{}{
+				"allowed_issuer_skis":     tf.FlattenStringSlicePtr(configuration.AllowedIssuerSkis),
+				"allowed_policy_oids":     tf.FlattenStringSlicePtr(configuration.AllowedPolicyOIDs),
+				"applies_to_combinations": appliesToCombinations,
+			})
+		}
+	}
+
+	tf.Set(d, "fido2_combination_configuration", fido2CombinationConfigurations)
+	tf.Set(d, "x509_certificate_combination_configuration", x509CertificateCombinationConfigurations)
+
 	return nil
 }
 
@@ -241,3 +399,141 @@ func authenticationStrengthPolicyDelete(ctx context.Context, d *pluginsdk.Resour
 
 	return nil
 }
+
+func expandAuthenticationStrengthPolicyCombinationConfigurations(d *pluginsdk.ResourceData) []stable.AuthenticationCombinationConfiguration {
+	result := make([]stable.AuthenticationCombinationConfiguration, 0)
+
+	for _, configurationRaw := range d.Get("fido2_combination_configuration").([]interface{}) {
+		if configurationRaw == nil {
+			continue
+		}
+		configuration := configurationRaw.(map[string]interface{})
+		result = append(result, stable.Fido2CombinationConfiguration{
+			AllowedAAGUIDs:        tf.ExpandStringSlicePtr(configuration["allowed_aaguids"].(*pluginsdk.Set).List()),
+			AppliesToCombinations: &[]stable.AuthenticationMethodModes{stable.AuthenticationMethodModes_Fido2},
+		})
+	}
+
+	for _, configurationRaw := range d.Get("x509_certificate_combination_configuration").([]interface{}) {
+		if configurationRaw == nil {
+			continue
+		}
+		configuration := configurationRaw.(map[string]interface{})
+		appliesToCombinations := make([]stable.AuthenticationMethodModes, 0)
+		for _, v := range configuration["applies_to_combinations"].(*pluginsdk.Set).List() {
+			appliesToCombinations = append(appliesToCombinations, stable.AuthenticationMethodModes(v.(string)))
+		}
+		result = append(result, stable.X509CertificateCombinationConfiguration{
+			AllowedIssuerSkis:     tf.ExpandStringSlicePtr(configuration["allowed_issuer_skis"].(*pluginsdk.Set).List()),
+			AllowedPolicyOIDs:     tf.ExpandStringSlicePtr(configuration["allowed_policy_oids"].(*pluginsdk.Set).List()),
+			AppliesToCombinations: &appliesToCombinations,
+		})
+	}
+
+	return result
+}
+
+func createAuthenticationStrengthPolicyCombinationConfigurations(ctx context.Context, client *authenticationstrengthpolicycombinationconfiguration.AuthenticationStrengthPolicyCombinationConfigurationClient, id stable.PolicyAuthenticationStrengthPolicyId, configurations []stable.AuthenticationCombinationConfiguration) error {
+	for _, configuration := range configurations {
+		if _, err := client.CreateAuthenticationStrengthPolicyCombinationConfiguration(ctx, id, configuration, authenticationstrengthpolicycombinationconfiguration.DefaultCreateAuthenticationStrengthPolicyCombinationConfigurationOperationOptions()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateAuthenticationStrengthPolicyAllowedCombinations(ctx context.Context, client *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient, id stable.PolicyAuthenticationStrengthPolicyId, allowedCombinations []string) error {
+	modes := make([]stable.AuthenticationMethodModes, 0)
+	for _, v := range allowedCombinations {
+		modes = append(modes, stable.AuthenticationMethodModes(v))
+	}
+
+	request := authenticationstrengthpolicy.UpdateAuthenticationStrengthPolicyAllowedCombinationsRequest{
+		AllowedCombinations: pointer.To(modes),
+	}
+
+	_, err := client.UpdateAuthenticationStrengthPolicyAllowedCombinations(ctx, id, request, authenticationstrengthpolicy.DefaultUpdateAuthenticationStrengthPolicyAllowedCombinationsOperationOptions())
+	return err
+}
+
+// updateAuthenticationStrengthPolicyCombinationConfigurations reconciles the existing combination configurations for a
+// policy with the desired configurations. Each desired configuration replaces an existing configuration of the same type
+// applying to any of the same combinations, and configurations without a counterpart are created. Existing configurations
+// which are no longer desired are only deleted once all other configurations have been written, so that a failed update
+// does not leave the policy without its restrictions.
+func updateAuthenticationStrengthPolicyCombinationConfigurations(ctx context.Context, client *authenticationstrengthpolicycombinationconfiguration.AuthenticationStrengthPolicyCombinationConfigurationClient, id stable.PolicyAuthenticationStrengthPolicyId, configurations []stable.AuthenticationCombinationConfiguration) error {
+	resp, err := client.ListAuthenticationStrengthPolicyCombinationConfigurations(ctx, id, authenticationstrengthpolicycombinationconfiguration.DefaultListAuthenticationStrengthPolicyCombinationConfigurationsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing combination configurations: %v", err)
+	}
+
+	existingConfigurations := pointer.From(resp.Model)
+	for _, existing := range existingConfigurations {
+		if pointer.From(existing.AuthenticationCombinationConfiguration().Id) == "" {
+			return errors.New("listing combination configurations: API returned combination configuration with nil ID")
+		}
+	}
+
+	matched := make([]bool, len(existingConfigurations))
+	newConfigurations := make([]stable.AuthenticationCombinationConfiguration, 0)
+
+	for _, configuration := range configurations {
+		match := -1
+		for i, existing := range existingConfigurations {
+			if !matched[i] && authenticationStrengthPolicyCombinationConfigurationsOverlap(existing, configuration) {
+				match = i
+				break
+			}
+		}
+
+		if match < 0 {
+			newConfigurations = append(newConfigurations, configuration)
+			continue
+		}
+
+		matched[match] = true
+		configurationId := pointer.From(existingConfigurations[match].AuthenticationCombinationConfiguration().Id)
+		if _, err = client.UpdateAuthenticationStrengthPolicyCombinationConfiguration(ctx, stable.NewPolicyAuthenticationStrengthPolicyIdCombinationConfigurationID(id.AuthenticationStrengthPolicyId, configurationId), configuration, authenticationstrengthpolicycombinationconfiguration.DefaultUpdateAuthenticationStrengthPolicyCombinationConfigurationOperationOptions()); err != nil {
+			return fmt.Errorf("updating combination configuration %q: %v", configurationId, err)
+		}
+	}
+
+	if err = createAuthenticationStrengthPolicyCombinationConfigurations(ctx, client, id, newConfigurations); err != nil {
+		return fmt.Errorf("creating combination configuration: %v", err)
+	}
+
+	for i, existing := range existingConfigurations {
+		if matched[i] {
+			continue
+		}
+		configurationId := pointer.From(existing.AuthenticationCombinationConfiguration().Id)
+		if _, err = client.DeleteAuthenticationStrengthPolicyCombinationConfiguration(ctx, stable.NewPolicyAuthenticationStrengthPolicyIdCombinationConfigurationID(id.AuthenticationStrengthPolicyId, configurationId), authenticationstrengthpolicycombinationconfiguration.DefaultDeleteAuthenticationStrengthPolicyCombinationConfigurationOperationOptions()); err != nil {
+			return fmt.Errorf("deleting combination configuration %q: %v", configurationId, err)
+		}
+	}
+
+	return nil
+}
+
+func authenticationStrengthPolicyCombinationConfigurationsOverlap(existing, desired stable.AuthenticationCombinationConfiguration) bool {
+	switch existing.(type) {
+	case stable.Fido2CombinationConfiguration:
+		if _, ok := desired.(stable.Fido2CombinationConfiguration); !ok {
+			return false
+		}
+	case stable.X509CertificateCombinationConfiguration:
+		if _, ok := desired.(stable.X509CertificateCombinationConfiguration); !ok {
+			return false
+		}
+	default:
+		return false
+	}
+
+	for _, v := range pointer.From(desired.AuthenticationCombinationConfiguration().AppliesToCombinations) {
+		if slices.Contains(pointer.From(existing.AuthenticationCombinationConfiguration().AppliesToCombinations), v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -79,6 +79,41 @@ func TestAccAuthenticationStrengthPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccAuthenticationStrengthPolicy_combinationConfigurations(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.combinationConfigurations(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fido2_combination_configuration.0.allowed_aaguids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("x509_certificate_combination_configuration.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.combinationConfigurationsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fido2_combination_configuration.0.allowed_aaguids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("x509_certificate_combination_configuration.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fido2_combination_configuration.#").HasValue("0"),
+				check.That(data.ResourceName).Key("x509_certificate_combination_configuration.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r AuthenticationStrengthPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.Policies.AuthenticationStrengthPolicyClient
 
@@ -143,3 +178,63 @@ resource "azuread_authentication_strength_policy" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (AuthenticationStrengthPolicyResource) combinationConfigurations(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_authentication_strength_policy" "test" {
+  display_name = "acctestASP-%[1]d"
+  description  = "test"
+  allowed_combinations = [
+    "fido2",
+    "x509CertificateMultiFactor",
+  ]
+
+  fido2_combination_configuration {
+    allowed_aaguids = [
+      "cb69481e-8ff7-4039-93ec-0a2729a154a8",
+      "ee882879-721c-4913-9775-3dfcce97072a",
+    ]
+  }
+
+  x509_certificate_combination_configuration {
+    applies_to_combinations = ["x509CertificateMultiFactor"]
+    allowed_issuer_skis     = ["9A4248C6AC8C2931AB2A86537818E92E7B6C97B6"]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (AuthenticationStrengthPolicyResource) combinationConfigurationsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_authentication_strength_policy" "test" {
+  display_name = "acctestASP-%[1]d"
+  description  = "test"
+  allowed_combinations = [
+    "fido2",
+    "x509CertificateMultiFactor",
+    "x509CertificateSingleFactor",
+  ]
+
+  fido2_combination_configuration {
+    allowed_aaguids = [
+      "cb69481e-8ff7-4039-93ec-0a2729a154a8",
+    ]
+  }
+
+  x509_certificate_combination_configuration {
+    applies_to_combinations = ["x509CertificateMultiFactor"]
+    allowed_issuer_skis     = ["9A4248C6AC8C2931AB2A86537818E92E7B6C97B6"]
+    allowed_policy_oids     = ["1.2.3.4"]
+  }
+
+  x509_certificate_combination_configuration {
+    applies_to_combinations = ["x509CertificateSingleFactor"]
+    allowed_issuer_skis     = ["9A4248C6AC8C2931AB2A86537818E92E7B6C97B6"]
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// authorizationPolicyId is the ID of the authorization policy, which always exists in a tenant
+const authorizationPolicyId = "/policies/authorizationPolicy"
+
+const (
+	guestUserRoleIdGuestUser           = "10dae51f-b6af-4016-8d66-8c2a99b929b3"
+	guestUserRoleIdRestrictedGuestUser = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+	guestUserRoleIdUser                = "a0b1b346-4d3e-4e8b-98f8-753987be4970"
+)
+
+func authorizationPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authorizationPolicyResourceCreateUpdate,
+		ReadContext:   authorizationPolicyResourceRead,
+		UpdateContext: authorizationPolicyResourceCreateUpdate,
+		DeleteContext: authorizationPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != authorizationPolicyId {
+				return fmt.Errorf("expected ID to be %q, got %q", authorizationPolicyId, id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"allow_email_verified_users_to_join_organization": {
+				Description: "Whether users can join the tenant by email validation",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allow_invites_from": {
+				Description:  "Who can invite guests to the organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAllowInvitesFrom(), false),
+			},
+
+			"allow_user_consent_for_risky_apps": {
+				Description: "Whether user consent for risky apps is allowed",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_to_sign_up_email_based_subscriptions": {
+				Description: "Whether users can sign up for email based subscriptions",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_to_use_self_service_password_reset": {
+				Description: "Whether administrators of the tenant can use self-service password reset",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"block_msol_powershell": {
+				Description: "Whether the use of MSOnline PowerShell is blocked",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"default_user_role_permissions": {
+				Description: "The permissions granted to the default user role",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_to_create_apps": {
+							Description: "Whether users can register applications",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_create_security_groups": {
+							Description: "Whether users can create security groups",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_create_tenants": {
+							Description: "Whether users can create tenants",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_read_bitlocker_keys_for_owned_device": {
+							Description: "Whether the registered owners of a device can read their own BitLocker recovery keys",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_read_other_users": {
+							Description: "Whether users can read other users",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"permission_grant_policy_ids": {
+							Description: "The IDs of the permission grant policies which govern user consent to apps",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"guest_user_role_id": {
+				Description:  "The ID of the role template to be granted to guest users",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{guestUserRoleIdGuestUser, guestUserRoleIdRestrictedGuestUser, guestUserRoleIdUser}, false),
+			},
+
+			"reset_on_destroy": {
+				Description: "Whether the authorization policy should be reset to its default settings when this resource is destroyed",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"display_name": {
+				Description: "The display name for the authorization policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func authorizationPolicyResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// The authorization policy always exists, so we check that it can be read before updating it
+	resp, err := client.GetAuthorizationPolicy(ctx, authorizationpolicy.DefaultGetAuthorizationPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving authorization policy")
+	}
+
+	// Only settings which are specified in the configuration are sent, any others retain their existing values
+	properties := stable.AuthorizationPolicy{}
+
+	if v, ok := d.GetOkExists("allow_email_verified_users_to_join_organization"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.AllowEmailVerifiedUsersToJoinOrganization = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOk("allow_invites_from"); ok {
+		properties.AllowInvitesFrom = pointer.To(stable.AllowInvitesFrom(v.(string)))
+	}
+	if v, ok := d.GetOkExists("allow_user_consent_for_risky_apps"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.AllowUserConsentForRiskyApps = nullable.Value(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_sign_up_email_based_subscriptions"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.AllowedToSignUpEmailBasedSubscriptions = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_use_self_service_password_reset"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.AllowedToUseSSPR = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOkExists("block_msol_powershell"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.BlockMsolPowerShell = nullable.Value(v.(bool))
+	}
+	if v, ok := d.GetOk("guest_user_role_id"); ok {
+		properties.GuestUserRoleId = nullable.Value(v.(string))
+	}
+
+	if d.Get("default_user_role_permissions.#").(int) > 0 {
+		permissions := stable.DefaultUserRolePermissions{}
+
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_apps"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateApps = pointer.To(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_security_groups"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateSecurityGroups = pointer.To(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_tenants"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateTenants = nullable.Value(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_read_bitlocker_keys_for_owned_device"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToReadBitlockerKeysForOwnedDevice = nullable.Value(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_read_other_users"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToReadOtherUsers = pointer.To(v.(bool))
+		}
+
+		// An empty set of permission grant policies disables user consent, so we check whether the property has been
+		// specified in the configuration rather than whether it is empty
+		if authorizationPolicyPermissionGrantPoliciesConfigured(d) {
+			permissions.PermissionGrantPoliciesAssigned = tf.ExpandStringSlicePtr(d.Get("default_user_role_permissions.0.permission_grant_policy_ids").(*pluginsdk.Set).List())
+		}
+
+		properties.DefaultUserRolePermissions = &permissions
+	}
+
+	if _, err = client.UpdateAuthorizationPolicy(ctx, properties, authorizationpolicy.DefaultUpdateAuthorizationPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update authorization policy")
+	}
+
+	d.SetId(authorizationPolicyId)
+
+	return authorizationPolicyResourceRead(ctx, d, meta)
+}
+
+func authorizationPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	resp, err := client.GetAuthorizationPolicy(ctx, authorizationpolicy.DefaultGetAuthorizationPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving authorization policy")
+	}
+
+	defaultUserRolePermissions := make([]map[string]interface{}, 0)
+	if permissions := policy.DefaultUserRolePermissions; permissions != nil {
+		defaultUserRolePermissions = append(defaultUserRolePermissions, map[string]interface{}{
+			"allowed_to_create_apps":                          pointer.From(permissions.AllowedToCreateApps),
+			"allowed_to_create_security_groups":               pointer.From(permissions.AllowedToCreateSecurityGroups),
+			"allowed_to_create_tenants":                       permissions.AllowedToCreateTenants.GetOrZero(),
+			"allowed_to_read_bitlocker_keys_for_owned_device": permissions.AllowedToReadBitlockerKeysForOwnedDevice.GetOrZero(),
+			"allowed_to_read_other_users":                     pointer.From(permissions.AllowedToReadOtherUsers),
+			"permission_grant_policy_ids":                     tf.FlattenStringSlicePtr(permissions.PermissionGrantPoliciesAssigned),
+		})
+	}
+
+	tf.Set(d, "allow_email_verified_users_to_join_organization", pointer.From(policy.AllowEmailVerifiedUsersToJoinOrganization))
+	tf.Set(d, "allow_invites_from", string(pointer.From(policy.AllowInvitesFrom)))
+	tf.Set(d, "allow_user_consent_for_risky_apps", policy.AllowUserConsentForRiskyApps.GetOrZero())
+	tf.Set(d, "allowed_to_sign_up_email_based_subscriptions", pointer.From(policy.AllowedToSignUpEmailBasedSubscriptions))
+	tf.Set(d, "allowed_to_use_self_service_password_reset", pointer.From(policy.AllowedToUseSSPR))
+	tf.Set(d, "block_msol_powershell", policy.BlockMsolPowerShell.GetOrZero())
+	tf.Set(d, "default_user_role_permissions", defaultUserRolePermissions)
+	tf.Set(d, "display_name", policy.DisplayName.GetOrZero())
+	tf.Set(d, "guest_user_role_id", policy.GuestUserRoleId.GetOrZero())
+
+	// reset_on_destroy is not returned by the API, so default it when importing
+	if _, ok := d.GetOk("reset_on_destroy"); !ok {
+		tf.Set(d, "reset_on_destroy", false)
+	}
+
+	return nil
+}
+
+func authorizationPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// The authorization policy cannot be deleted, so by default it is left in place
+	if !d.Get("reset_on_destroy").(bool) {
+		log.Printf("[DEBUG] Leaving authorization policy in place as `reset_on_destroy` is not enabled")
+		return nil
+	}
+
+	properties := stable.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: pointer.To(true),
+		AllowInvitesFrom:                       pointer.To(stable.AllowInvitesFrom_Everyone),
+		AllowUserConsentForRiskyApps:           nullable.Value(false),
+		AllowedToSignUpEmailBasedSubscriptions: pointer.To(true),
+		AllowedToUseSSPR:                       pointer.To(true),
+		BlockMsolPowerShell:                    nullable.Value(false),
+		DefaultUserRolePermissions: &stable.DefaultUserRolePermissions{
+			AllowedToCreateApps:                      pointer.To(true),
+			AllowedToCreateSecurityGroups:            pointer.To(true),
+			AllowedToCreateTenants:                   nullable.Value(true),
+			AllowedToReadBitlockerKeysForOwnedDevice: nullable.Value(true),
+			AllowedToReadOtherUsers:                  pointer.To(true),
+			PermissionGrantPoliciesAssigned:          pointer.To([]string{"ManagePermissionGrantsForSelf.microsoft-user-default-legacy"}),
+		},
+		GuestUserRoleId: nullable.Value(guestUserRoleIdGuestUser),
+	}
+
+	if _, err := client.UpdateAuthorizationPolicy(ctx, properties, authorizationpolicy.DefaultUpdateAuthorizationPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Resetting authorization policy to default settings")
+	}
+
+	return nil
+}
+
+// authorizationPolicyPermissionGrantPoliciesConfigured returns whether `permission_grant_policy_ids` has been specified
+// in the configuration, which cannot be determined with GetOk() when an empty set is configured
+func authorizationPolicyPermissionGrantPoliciesConfigured(d *pluginsdk.ResourceData) bool {
+	permissions := d.GetRawConfig().GetAttr("default_user_role_permissions")
+	if permissions.IsNull() || !permissions.IsKnown() {
+		return false
+	}
+
+	for _, block := range permissions.AsValueSlice() {
+		if v := block.GetAttr("permission_grant_policy_ids"); !v.IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
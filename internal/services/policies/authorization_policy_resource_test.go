@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthorizationPolicyResource struct{}
+
+func TestAccAuthorizationPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+	r := AuthorizationPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+				check.That(data.ResourceName).Key("default_user_role_permissions.0.allowed_to_create_apps").HasValue("false"),
+			),
+		},
+		data.ImportStep("reset_on_destroy"),
+	})
+}
+
+func TestAccAuthorizationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+	r := AuthorizationPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("reset_on_destroy"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guest_user_role_id").HasValue("2af84b1e-32c8-42b7-82bc-daa82404023b"),
+				check.That(data.ResourceName).Key("default_user_role_permissions.0.permission_grant_policy_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("reset_on_destroy"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("reset_on_destroy"),
+	})
+}
+
+func (r AuthorizationPolicyResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthorizationPolicyClient
+
+	resp, err := client.GetAuthorizationPolicy(ctx, authorizationpolicy.DefaultGetAuthorizationPolicyOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve authorization policy: %v", err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (AuthorizationPolicyResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_invites_from = "adminsAndGuestInviters"
+  reset_on_destroy   = true
+
+  default_user_role_permissions {
+    allowed_to_create_apps = false
+  }
+}
+`
+}
+
+func (AuthorizationPolicyResource) complete(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_email_verified_users_to_join_organization = false
+  allow_invites_from                              = "adminsAndGuestInviters"
+  allow_user_consent_for_risky_apps               = false
+  allowed_to_sign_up_email_based_subscriptions    = false
+  allowed_to_use_self_service_password_reset      = true
+  block_msol_powershell                           = true
+  guest_user_role_id                              = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+  reset_on_destroy                                = true
+
+  default_user_role_permissions {
+    allowed_to_create_apps                          = false
+    allowed_to_create_security_groups               = false
+    allowed_to_create_tenants                       = false
+    allowed_to_read_bitlocker_keys_for_owned_device = true
+    allowed_to_read_other_users                     = true
+    permission_grant_policy_ids                     = []
+  }
+}
+`
+}
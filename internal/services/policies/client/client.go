@@ -4,33 +4,107 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationstrengthauthenticationmethodmode"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationmethodspolicyauthenticationmethodconfiguration"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicycombinationconfiguration"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/claimsmappingpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicydefault"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartner"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartneridentitysynchronization"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/identitysecuritydefaultsenforcementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/sdk/continuousaccessevaluationpolicy"
 )
 
 type Client struct {
-	AuthenticationStrengthPolicyClient   *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient
-	ClaimsMappingPolicyClient            *claimsmappingpolicy.ClaimsMappingPolicyClient
-	RoleManagementPolicyAssignmentClient *rolemanagementpolicyassignment.RoleManagementPolicyAssignmentClient
-	RoleManagementPolicyClient           *rolemanagementpolicy.RoleManagementPolicyClient
+	AuthenticationMethodConfigurationClient              *authenticationmethodspolicyauthenticationmethodconfiguration.AuthenticationMethodsPolicyAuthenticationMethodConfigurationClient
+	AuthenticationMethodsPolicyClient                    *authenticationmethodspolicy.AuthenticationMethodsPolicyClient
+	AuthenticationMethodModeClient                       *conditionalaccessauthenticationstrengthauthenticationmethodmode.ConditionalAccessAuthenticationStrengthAuthenticationMethodModeClient
+	AuthenticationStrengthCombinationConfigurationClient *authenticationstrengthpolicycombinationconfiguration.AuthenticationStrengthPolicyCombinationConfigurationClient
+	AuthenticationStrengthPolicyClient                   *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient
+	AuthorizationPolicyClient                            *authorizationpolicy.AuthorizationPolicyClient
+	ClaimsMappingPolicyClient                            *claimsmappingpolicy.ClaimsMappingPolicyClient
+	ContinuousAccessEvaluationPolicyClient               *continuousaccessevaluationpolicy.ContinuousAccessEvaluationPolicyClient
+	CrossTenantAccessDefaultClient                       *crosstenantaccesspolicydefault.CrossTenantAccessPolicyDefaultClient
+	CrossTenantAccessPartnerClient                       *crosstenantaccesspolicypartner.CrossTenantAccessPolicyPartnerClient
+	CrossTenantIdentitySyncClient                        *crosstenantaccesspolicypartneridentitysynchronization.CrossTenantAccessPolicyPartnerIdentitySynchronizationClient
+	RoleManagementPolicyAssignmentClient                 *rolemanagementpolicyassignment.RoleManagementPolicyAssignmentClient
+	RoleManagementPolicyClient                           *rolemanagementpolicy.RoleManagementPolicyClient
+	SecurityDefaultsClient                               *identitysecuritydefaultsenforcementpolicy.IdentitySecurityDefaultsEnforcementPolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationMethodConfigurationClient, err := authenticationmethodspolicyauthenticationmethodconfiguration.NewAuthenticationMethodsPolicyAuthenticationMethodConfigurationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationMethodConfigurationClient.Client)
+
+	authenticationMethodsPolicyClient, err := authenticationmethodspolicy.NewAuthenticationMethodsPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationMethodsPolicyClient.Client)
+
+	authenticationMethodModeClient, err := conditionalaccessauthenticationstrengthauthenticationmethodmode.NewConditionalAccessAuthenticationStrengthAuthenticationMethodModeClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationMethodModeClient.Client)
+
+	authenticationStrengthCombinationConfigurationClient, err := authenticationstrengthpolicycombinationconfiguration.NewAuthenticationStrengthPolicyCombinationConfigurationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationStrengthCombinationConfigurationClient.Client)
+
 	authenticationStrengthpolicyClient, err := authenticationstrengthpolicy.NewAuthenticationStrengthPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(authenticationStrengthpolicyClient.Client)
 
+	authorizationPolicyClient, err := authorizationpolicy.NewAuthorizationPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authorizationPolicyClient.Client)
+
 	claimsMappingPolicyClient, err := claimsmappingpolicy.NewClaimsMappingPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(claimsMappingPolicyClient.Client)
 
+	continuousAccessEvaluationPolicyClient, err := continuousaccessevaluationpolicy.NewContinuousAccessEvaluationPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(continuousAccessEvaluationPolicyClient.Client)
+
+	crossTenantAccessDefaultClient, err := crosstenantaccesspolicydefault.NewCrossTenantAccessPolicyDefaultClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(crossTenantAccessDefaultClient.Client)
+
+	crossTenantAccessPartnerClient, err := crosstenantaccesspolicypartner.NewCrossTenantAccessPolicyPartnerClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(crossTenantAccessPartnerClient.Client)
+
+	crossTenantIdentitySyncClient, err := crosstenantaccesspolicypartneridentitysynchronization.NewCrossTenantAccessPolicyPartnerIdentitySynchronizationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(crossTenantIdentitySyncClient.Client)
+
 	roleManagementPolicyAssignmentClient, err := rolemanagementpolicyassignment.NewRoleManagementPolicyAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -43,10 +117,26 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(roleManagementPolicyClient.Client)
 
+	securityDefaultsClient, err := identitysecuritydefaultsenforcementpolicy.NewIdentitySecurityDefaultsEnforcementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(securityDefaultsClient.Client)
+
 	return &Client{
-		AuthenticationStrengthPolicyClient:   authenticationStrengthpolicyClient,
-		ClaimsMappingPolicyClient:            claimsMappingPolicyClient,
-		RoleManagementPolicyAssignmentClient: roleManagementPolicyAssignmentClient,
-		RoleManagementPolicyClient:           roleManagementPolicyClient,
+		AuthenticationMethodConfigurationClient:              authenticationMethodConfigurationClient,
+		AuthenticationMethodsPolicyClient:                    authenticationMethodsPolicyClient,
+		AuthenticationMethodModeClient:                       authenticationMethodModeClient,
+		AuthenticationStrengthCombinationConfigurationClient: authenticationStrengthCombinationConfigurationClient,
+		AuthenticationStrengthPolicyClient:                   authenticationStrengthpolicyClient,
+		AuthorizationPolicyClient:                            authorizationPolicyClient,
+		ClaimsMappingPolicyClient:                            claimsMappingPolicyClient,
+		ContinuousAccessEvaluationPolicyClient:               continuousAccessEvaluationPolicyClient,
+		CrossTenantAccessDefaultClient:                       crossTenantAccessDefaultClient,
+		CrossTenantAccessPartnerClient:                       crossTenantAccessPartnerClient,
+		CrossTenantIdentitySyncClient:                        crossTenantIdentitySyncClient,
+		RoleManagementPolicyAssignmentClient:                 roleManagementPolicyAssignmentClient,
+		RoleManagementPolicyClient:                           roleManagementPolicyClient,
+		SecurityDefaultsClient:                               securityDefaultsClient,
 	}, nil
 }
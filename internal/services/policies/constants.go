@@ -9,3 +9,25 @@ const (
 )
 
 var possibleValuesForRoleDefinitionId = []string{RoleDefinitionIdMember, RoleDefinitionIdOwner}
+
+const (
+	authenticationMethodEmail                  = "Email"
+	authenticationMethodFido2                  = "Fido2"
+	authenticationMethodMicrosoftAuthenticator = "MicrosoftAuthenticator"
+	authenticationMethodSms                    = "Sms"
+	authenticationMethodSoftwareOath           = "SoftwareOath"
+	authenticationMethodTemporaryAccessPass    = "TemporaryAccessPass"
+	authenticationMethodVoice                  = "Voice"
+	authenticationMethodX509Certificate        = "X509Certificate"
+)
+
+var possibleValuesForAuthenticationMethod = []string{
+	authenticationMethodEmail,
+	authenticationMethodFido2,
+	authenticationMethodMicrosoftAuthenticator,
+	authenticationMethodSms,
+	authenticationMethodSoftwareOath,
+	authenticationMethodTemporaryAccessPass,
+	authenticationMethodVoice,
+	authenticationMethodX509Certificate,
+}
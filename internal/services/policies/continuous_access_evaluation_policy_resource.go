@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/sdk/continuousaccessevaluationpolicy"
+)
+
+// continuousAccessEvaluationPolicyId is the ID of the continuous access evaluation policy, which always exists in a tenant
+const continuousAccessEvaluationPolicyId = "/identity/continuousAccessEvaluationPolicy"
+
+func continuousAccessEvaluationPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: continuousAccessEvaluationPolicyResourceCreateUpdate,
+		ReadContext:   continuousAccessEvaluationPolicyResourceRead,
+		UpdateContext: continuousAccessEvaluationPolicyResourceCreateUpdate,
+		DeleteContext: continuousAccessEvaluationPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != continuousAccessEvaluationPolicyId {
+				return fmt.Errorf("expected ID to be %q, got %q", continuousAccessEvaluationPolicyId, id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"enabled": {
+				Description: "Whether continuous access evaluation is enabled for the tenant",
+				Type:        pluginsdk.TypeBool,
+				Required:    true,
+			},
+
+			"group_ids": {
+				Description: "The object IDs of groups whose members are subject to continuous access evaluation. When empty, all users are included",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"user_ids": {
+				Description: "The object IDs of users who are subject to continuous access evaluation. When empty, all users are included",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"description": {
+				Description: "The description of the continuous access evaluation policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the continuous access evaluation policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func continuousAccessEvaluationPolicyResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ContinuousAccessEvaluationPolicyClient
+
+	properties := beta.ContinuousAccessEvaluationPolicy{
+		Groups:    tf.ExpandStringSlicePtr(d.Get("group_ids").(*pluginsdk.Set).List()),
+		IsEnabled: pointer.To(d.Get("enabled").(bool)),
+		Users:     tf.ExpandStringSlicePtr(d.Get("user_ids").(*pluginsdk.Set).List()),
+	}
+
+	if _, err := client.UpdateContinuousAccessEvaluationPolicy(ctx, properties, continuousaccessevaluationpolicy.DefaultUpdateContinuousAccessEvaluationPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update continuous access evaluation policy")
+	}
+
+	d.SetId(continuousAccessEvaluationPolicyId)
+
+	return continuousAccessEvaluationPolicyResourceRead(ctx, d, meta)
+}
+
+func continuousAccessEvaluationPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ContinuousAccessEvaluationPolicyClient
+
+	resp, err := client.GetContinuousAccessEvaluationPolicy(ctx, continuousaccessevaluationpolicy.DefaultGetContinuousAccessEvaluationPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving continuous access evaluation policy")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving continuous access evaluation policy")
+	}
+
+	tf.Set(d, "description", pointer.From(policy.Description))
+	tf.Set(d, "display_name", pointer.From(policy.DisplayName))
+	tf.Set(d, "enabled", pointer.From(policy.IsEnabled))
+	tf.Set(d, "group_ids", tf.FlattenStringSlicePtr(policy.Groups))
+	tf.Set(d, "user_ids", tf.FlattenStringSlicePtr(policy.Users))
+
+	return nil
+}
+
+func continuousAccessEvaluationPolicyResourceDelete(ctx context.Context, _ *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ContinuousAccessEvaluationPolicyClient
+
+	// The continuous access evaluation policy cannot be deleted, so instead we restore the default of enabling it for all users
+	properties := beta.ContinuousAccessEvaluationPolicy{
+		Groups:    &[]string{},
+		IsEnabled: pointer.To(true),
+		Users:     &[]string{},
+	}
+
+	if _, err := client.UpdateContinuousAccessEvaluationPolicy(ctx, properties, continuousaccessevaluationpolicy.DefaultUpdateContinuousAccessEvaluationPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Resetting continuous access evaluation policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/sdk/continuousaccessevaluationpolicy"
+)
+
+type ContinuousAccessEvaluationPolicyResource struct{}
+
+func TestAccContinuousAccessEvaluationPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_continuous_access_evaluation_policy", "test")
+	r := ContinuousAccessEvaluationPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContinuousAccessEvaluationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_continuous_access_evaluation_policy", "test")
+	r := ContinuousAccessEvaluationPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.targeted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContinuousAccessEvaluationPolicyResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.ContinuousAccessEvaluationPolicyClient
+
+	resp, err := client.GetContinuousAccessEvaluationPolicy(ctx, continuousaccessevaluationpolicy.DefaultGetContinuousAccessEvaluationPolicyOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve continuous access evaluation policy: %v", err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ContinuousAccessEvaluationPolicyResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_continuous_access_evaluation_policy" "test" {
+  enabled = true
+}
+`
+}
+
+func (ContinuousAccessEvaluationPolicyResource) targeted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestCAE-%[1]s"
+  security_enabled = true
+}
+
+resource "azuread_continuous_access_evaluation_policy" "test" {
+  enabled   = true
+  group_ids = [azuread_group.test.object_id]
+}
+`, data.RandomString)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func crossTenantAccessPolicyB2BSettingSchema(description string, computed bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    computed,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"applications": crossTenantAccessPolicyTargetConfigurationSchema("The applications targeted by this setting"),
+
+				"users_and_groups": crossTenantAccessPolicyTargetConfigurationSchema("The users and groups targeted by this setting"),
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyTargetConfigurationSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"access_type": {
+					Description:  "Whether access is allowed or blocked for the specified targets",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(stable.PossibleValuesForCrossTenantAccessPolicyTargetConfigurationAccessType(), false),
+				},
+
+				"target": {
+					Description: "The users, groups or applications targeted",
+					Type:        pluginsdk.TypeList,
+					Required:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"target": {
+								Description:  "The object ID of the user, group or application, or one of `AllUsers`, `AllApplications` or `Office365`",
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"target_type": {
+								Description:  "The type of the target",
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(stable.PossibleValuesForCrossTenantAccessPolicyTargetType(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyInboundTrustSchema(computed bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "Determines whether claims from external Microsoft Entra organizations are trusted by conditional access policies",
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    computed,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"compliant_device_accepted": {
+					Description: "Whether compliant devices from external organizations are trusted",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+
+				"hybrid_azure_ad_joined_device_accepted": {
+					Description: "Whether hybrid Microsoft Entra joined devices from external organizations are trusted",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+
+				"mfa_accepted": {
+					Description: "Whether multi-factor authentication from external organizations is trusted",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func expandCrossTenantAccessPolicyB2BSetting(in []interface{}) stable.CrossTenantAccessPolicyB2BSetting {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return stable.BaseCrossTenantAccessPolicyB2BSettingImpl{
+		Applications:   expandCrossTenantAccessPolicyTargetConfiguration(config["applications"].([]interface{})),
+		UsersAndGroups: expandCrossTenantAccessPolicyTargetConfiguration(config["users_and_groups"].([]interface{})),
+	}
+}
+
+// copyCrossTenantAccessPolicyB2BSetting returns a setting suitable for sending in a request payload, since settings
+// returned by the API are unmarshaled into a raw implementation which cannot be marshaled
+func copyCrossTenantAccessPolicyB2BSetting(in stable.CrossTenantAccessPolicyB2BSetting) stable.CrossTenantAccessPolicyB2BSetting {
+	if in == nil {
+		return nil
+	}
+
+	base := in.CrossTenantAccessPolicyB2BSetting()
+
+	return stable.BaseCrossTenantAccessPolicyB2BSettingImpl{
+		Applications:   base.Applications,
+		UsersAndGroups: base.UsersAndGroups,
+	}
+}
+
+func expandCrossTenantAccessPolicyTenantRestrictions(in []interface{}) *stable.CrossTenantAccessPolicyTenantRestrictions {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return &stable.CrossTenantAccessPolicyTenantRestrictions{
+		Applications:   expandCrossTenantAccessPolicyTargetConfiguration(config["applications"].([]interface{})),
+		UsersAndGroups: expandCrossTenantAccessPolicyTargetConfiguration(config["users_and_groups"].([]interface{})),
+	}
+}
+
+func expandCrossTenantAccessPolicyTargetConfiguration(in []interface{}) *stable.CrossTenantAccessPolicyTargetConfiguration {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	config := in[0].(map[string]interface{})
+
+	targets := make([]stable.CrossTenantAccessPolicyTarget, 0)
+	for _, targetRaw := range config["target"].([]interface{}) {
+		if targetRaw == nil {
+			continue
+		}
+		target := targetRaw.(map[string]interface{})
+		targets = append(targets, stable.CrossTenantAccessPolicyTarget{
+			Target:     nullable.Value(target["target"].(string)),
+			TargetType: pointer.To(stable.CrossTenantAccessPolicyTargetType(target["target_type"].(string))),
+		})
+	}
+
+	return &stable.CrossTenantAccessPolicyTargetConfiguration{
+		AccessType: pointer.To(stable.CrossTenantAccessPolicyTargetConfigurationAccessType(config["access_type"].(string))),
+		Targets:    &targets,
+	}
+}
+
+// expandCrossTenantAccessPolicyInboundTrust returns an inbound trust configuration with null values when the block is
+// not specified, which causes a partner configuration to inherit the default inbound trust settings
+func expandCrossTenantAccessPolicyInboundTrust(in []interface{}) *stable.CrossTenantAccessPolicyInboundTrust {
+	if len(in) == 0 || in[0] == nil {
+		inboundTrust := stable.CrossTenantAccessPolicyInboundTrust{}
+		inboundTrust.IsCompliantDeviceAccepted.SetNull()
+		inboundTrust.IsHybridAzureADJoinedDeviceAccepted.SetNull()
+		inboundTrust.IsMfaAccepted.SetNull()
+		return &inboundTrust
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return &stable.CrossTenantAccessPolicyInboundTrust{
+		IsCompliantDeviceAccepted:           nullable.Value(config["compliant_device_accepted"].(bool)),
+		IsHybridAzureADJoinedDeviceAccepted: nullable.Value(config["hybrid_azure_ad_joined_device_accepted"].(bool)),
+		IsMfaAccepted:                       nullable.Value(config["mfa_accepted"].(bool)),
+	}
+}
+
+func flattenCrossTenantAccessPolicyB2BSetting(in stable.CrossTenantAccessPolicyB2BSetting) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	base := in.CrossTenantAccessPolicyB2BSetting()
+	if base.Applications == nil && base.UsersAndGroups == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"applications":     flattenCrossTenantAccessPolicyTargetConfiguration(base.Applications),
+		"users_and_groups": flattenCrossTenantAccessPolicyTargetConfiguration(base.UsersAndGroups),
+	}}
+}
+
+func flattenCrossTenantAccessPolicyTenantRestrictions(in *stable.CrossTenantAccessPolicyTenantRestrictions) []map[string]interface{} {
+	if in == nil || (in.Applications == nil && in.UsersAndGroups == nil) {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"applications":     flattenCrossTenantAccessPolicyTargetConfiguration(in.Applications),
+		"users_and_groups": flattenCrossTenantAccessPolicyTargetConfiguration(in.UsersAndGroups),
+	}}
+}
+
+func flattenCrossTenantAccessPolicyTargetConfiguration(in *stable.CrossTenantAccessPolicyTargetConfiguration) []map[string]interface{} {
+	if in == nil || in.AccessType == nil {
+		return []map[string]interface{}{}
+	}
+
+	targets := make([]map[string]interface{}, 0)
+	if in.Targets != nil {
+		for _, target := range *in.Targets {
+			targets = append(targets, map[string]interface{}{
+				"target":      target.Target.GetOrZero(),
+				"target_type": string(pointer.From(target.TargetType)),
+			})
+		}
+	}
+
+	return []map[string]interface{}{{
+		"access_type": string(*in.AccessType),
+		"target":      targets,
+	}}
+}
+
+func flattenCrossTenantAccessPolicyInboundTrust(in *stable.CrossTenantAccessPolicyInboundTrust) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	// Null values indicate that a partner configuration inherits the default inbound trust settings
+	if in.IsCompliantDeviceAccepted.Get() == nil && in.IsHybridAzureADJoinedDeviceAccepted.Get() == nil && in.IsMfaAccepted.Get() == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"compliant_device_accepted":              in.IsCompliantDeviceAccepted.GetOrZero(),
+		"hybrid_azure_ad_joined_device_accepted": in.IsHybridAzureADJoinedDeviceAccepted.GetOrZero(),
+		"mfa_accepted":                           in.IsMfaAccepted.GetOrZero(),
+	}}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicydefault"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// crossTenantAccessDefaultId is the ID of the default cross-tenant access configuration, which always exists in a tenant
+const crossTenantAccessDefaultId = "/policies/crossTenantAccessPolicy/default"
+
+func crossTenantAccessDefaultResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: crossTenantAccessDefaultResourceCreateUpdate,
+		ReadContext:   crossTenantAccessDefaultResourceRead,
+		UpdateContext: crossTenantAccessDefaultResourceCreateUpdate,
+		DeleteContext: crossTenantAccessDefaultResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != crossTenantAccessDefaultId {
+				return fmt.Errorf("expected ID to be %q, got %q", crossTenantAccessDefaultId, id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"b2b_collaboration_inbound": crossTenantAccessPolicyB2BSettingSchema("The default configuration for users from other organizations accessing your resources via B2B collaboration", true),
+
+			"b2b_collaboration_outbound": crossTenantAccessPolicyB2BSettingSchema("The default configuration for users in your organization accessing resources in other organizations via B2B collaboration", true),
+
+			"b2b_direct_connect_inbound": crossTenantAccessPolicyB2BSettingSchema("The default configuration for users from other organizations accessing your resources via B2B direct connect", true),
+
+			"b2b_direct_connect_outbound": crossTenantAccessPolicyB2BSettingSchema("The default configuration for users in your organization accessing resources in other organizations via B2B direct connect", true),
+
+			"inbound_trust": crossTenantAccessPolicyInboundTrustSchema(true),
+
+			"tenant_restrictions": crossTenantAccessPolicyB2BSettingSchema("The default tenant restrictions for users in your organization accessing external organizations on your network or devices", true),
+
+			"service_default": {
+				Description: "Whether the default configuration is set to the system default configuration",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func crossTenantAccessDefaultResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessDefaultClient
+
+	resp, err := client.GetCrossTenantAccessPolicyDefault(ctx, crosstenantaccesspolicydefault.DefaultGetCrossTenantAccessPolicyDefaultOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving default cross-tenant access configuration")
+	}
+
+	existing := resp.Model
+	if existing == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving default cross-tenant access configuration")
+	}
+
+	// The default configuration always exists and the B2B settings cannot be removed, so any settings which are not
+	// configured are sent back with their existing values
+	properties := stable.CrossTenantAccessPolicyConfigurationDefault{
+		B2bCollaborationInbound:  copyCrossTenantAccessPolicyB2BSetting(existing.B2bCollaborationInbound),
+		B2bCollaborationOutbound: copyCrossTenantAccessPolicyB2BSetting(existing.B2bCollaborationOutbound),
+		B2bDirectConnectInbound:  copyCrossTenantAccessPolicyB2BSetting(existing.B2bDirectConnectInbound),
+		B2bDirectConnectOutbound: copyCrossTenantAccessPolicyB2BSetting(existing.B2bDirectConnectOutbound),
+		InboundTrust:             existing.InboundTrust,
+		TenantRestrictions:       existing.TenantRestrictions,
+	}
+
+	if v, ok := d.GetOk("b2b_collaboration_inbound"); ok {
+		properties.B2bCollaborationInbound = expandCrossTenantAccessPolicyB2BSetting(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("b2b_collaboration_outbound"); ok {
+		properties.B2bCollaborationOutbound = expandCrossTenantAccessPolicyB2BSetting(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("b2b_direct_connect_inbound"); ok {
+		properties.B2bDirectConnectInbound = expandCrossTenantAccessPolicyB2BSetting(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("b2b_direct_connect_outbound"); ok {
+		properties.B2bDirectConnectOutbound = expandCrossTenantAccessPolicyB2BSetting(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("inbound_trust"); ok {
+		properties.InboundTrust = expandCrossTenantAccessPolicyInboundTrust(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("tenant_restrictions"); ok {
+		properties.TenantRestrictions = expandCrossTenantAccessPolicyTenantRestrictions(v.([]interface{}))
+	}
+
+	if _, err = client.UpdateCrossTenantAccessPolicyDefault(ctx, properties, crosstenantaccesspolicydefault.DefaultUpdateCrossTenantAccessPolicyDefaultOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update default cross-tenant access configuration")
+	}
+
+	d.SetId(crossTenantAccessDefaultId)
+
+	return crossTenantAccessDefaultResourceRead(ctx, d, meta)
+}
+
+func crossTenantAccessDefaultResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessDefaultClient
+
+	resp, err := client.GetCrossTenantAccessPolicyDefault(ctx, crosstenantaccesspolicydefault.DefaultGetCrossTenantAccessPolicyDefaultOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving default cross-tenant access configuration")
+	}
+
+	configuration := resp.Model
+	if configuration == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving default cross-tenant access configuration")
+	}
+
+	tf.Set(d, "b2b_collaboration_inbound", flattenCrossTenantAccessPolicyB2BSetting(configuration.B2bCollaborationInbound))
+	tf.Set(d, "b2b_collaboration_outbound", flattenCrossTenantAccessPolicyB2BSetting(configuration.B2bCollaborationOutbound))
+	tf.Set(d, "b2b_direct_connect_inbound", flattenCrossTenantAccessPolicyB2BSetting(configuration.B2bDirectConnectInbound))
+	tf.Set(d, "b2b_direct_connect_outbound", flattenCrossTenantAccessPolicyB2BSetting(configuration.B2bDirectConnectOutbound))
+	tf.Set(d, "inbound_trust", flattenCrossTenantAccessPolicyInboundTrust(configuration.InboundTrust))
+	tf.Set(d, "service_default", configuration.IsServiceDefault.GetOrZero())
+	tf.Set(d, "tenant_restrictions", flattenCrossTenantAccessPolicyTenantRestrictions(configuration.TenantRestrictions))
+
+	return nil
+}
+
+func crossTenantAccessDefaultResourceDelete(ctx context.Context, _ *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessDefaultClient
+
+	// The default configuration cannot be deleted, so instead we reset it to the system default configuration
+	if _, err := client.ResetCrossTenantAccessPolicyDefaultToSystemDefault(ctx, crosstenantaccesspolicydefault.DefaultResetCrossTenantAccessPolicyDefaultToSystemDefaultOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Resetting default cross-tenant access configuration to system default")
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicydefault"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type CrossTenantAccessDefaultResource struct{}
+
+func TestAccCrossTenantAccessDefault_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_default", "test")
+	r := CrossTenantAccessDefaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("b2b_collaboration_inbound.#").HasValue("1"),
+				check.That(data.ResourceName).Key("inbound_trust.0.mfa_accepted").HasValue("true"),
+				check.That(data.ResourceName).Key("service_default").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessDefault_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_default", "test")
+	r := CrossTenantAccessDefaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("b2b_direct_connect_inbound.0.users_and_groups.0.access_type").HasValue("blocked"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessDefaultResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.CrossTenantAccessDefaultClient
+
+	resp, err := client.GetCrossTenantAccessPolicyDefault(ctx, crosstenantaccesspolicydefault.DefaultGetCrossTenantAccessPolicyDefaultOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve default cross-tenant access configuration: %v", err)
+	}
+
+	// The default configuration always exists, so we consider it to be managed when it has been customized
+	return pointer.To(resp.Model != nil && !resp.Model.IsServiceDefault.GetOrZero()), nil
+}
+
+func (CrossTenantAccessDefaultResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_default" "test" {
+  b2b_collaboration_inbound {
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  inbound_trust {
+    mfa_accepted = true
+  }
+}
+`
+}
+
+func (CrossTenantAccessDefaultResource) complete(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_default" "test" {
+  b2b_collaboration_inbound {
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  b2b_direct_connect_inbound {
+    applications {
+      access_type = "blocked"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "blocked"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  inbound_trust {
+    compliant_device_accepted              = true
+    hybrid_azure_ad_joined_device_accepted = true
+    mfa_accepted                           = true
+  }
+}
+`
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartneridentitysynchronization"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+func crossTenantAccessPartnerIdentitySynchronizationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: crossTenantAccessPartnerIdentitySynchronizationResourceCreateUpdate,
+		ReadContext:   crossTenantAccessPartnerIdentitySynchronizationResourceRead,
+		UpdateContext: crossTenantAccessPartnerIdentitySynchronizationResourceCreateUpdate,
+		DeleteContext: crossTenantAccessPartnerIdentitySynchronizationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseCrossTenantAccessPartnerIdentitySynchronizationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"tenant_id": {
+				Description:  "The tenant ID of the partner organization from which users are synchronized",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"display_name": {
+				Description:  "The display name for the cross-tenant user synchronization policy",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"user_sync_inbound_allowed": {
+				Description: "Whether users can be synchronized from the partner tenant",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func crossTenantAccessPartnerIdentitySynchronizationResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantIdentitySyncClient
+	tenantId := d.Get("tenant_id").(string)
+	partnerId := stable.NewPolicyCrossTenantAccessPolicyPartnerID(tenantId)
+	id := parse.NewCrossTenantAccessPartnerIdentitySynchronizationID(tenantId)
+
+	if d.IsNewResource() {
+		resp, err := client.GetCrossTenantAccessPolicyPartnerIdentitySynchronization(ctx, partnerId, crosstenantaccesspolicypartneridentitysynchronization.DefaultGetCrossTenantAccessPolicyPartnerIdentitySynchronizationOperationOptions())
+		if err != nil {
+			if !response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagF(err, "Checking for existing %s", id)
+			}
+		} else {
+			return tf.ImportAsExistsDiag("azuread_cross_tenant_access_partner_identity_synchronization", id.ID())
+		}
+	}
+
+	properties := stable.CrossTenantIdentitySyncPolicyPartner{
+		DisplayName: nullable.NoZero(d.Get("display_name").(string)),
+		UserSyncInbound: &stable.CrossTenantUserSyncInbound{
+			IsSyncAllowed: nullable.Value(d.Get("user_sync_inbound_allowed").(bool)),
+		},
+	}
+
+	// The identity synchronization policy is created or replaced with a PUT request
+	if _, err := client.SetCrossTenantAccessPolicyPartnerIdentitySynchronization(ctx, partnerId, properties, crosstenantaccesspolicypartneridentitysynchronization.DefaultSetCrossTenantAccessPolicyPartnerIdentitySynchronizationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not set %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	return crossTenantAccessPartnerIdentitySynchronizationResourceRead(ctx, d, meta)
+}
+
+func crossTenantAccessPartnerIdentitySynchronizationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantIdentitySyncClient
+
+	id, err := parse.ParseCrossTenantAccessPartnerIdentitySynchronizationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	partnerId := stable.NewPolicyCrossTenantAccessPolicyPartnerID(id.TenantId)
+
+	resp, err := client.GetCrossTenantAccessPolicyPartnerIdentitySynchronization(ctx, partnerId, crosstenantaccesspolicypartneridentitysynchronization.DefaultGetCrossTenantAccessPolicyPartnerIdentitySynchronizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	userSyncInboundAllowed := false
+	if policy.UserSyncInbound != nil {
+		userSyncInboundAllowed = policy.UserSyncInbound.IsSyncAllowed.GetOrZero()
+	}
+
+	tf.Set(d, "display_name", policy.DisplayName.GetOrZero())
+	tf.Set(d, "tenant_id", id.TenantId)
+	tf.Set(d, "user_sync_inbound_allowed", userSyncInboundAllowed)
+
+	return nil
+}
+
+func crossTenantAccessPartnerIdentitySynchronizationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantIdentitySyncClient
+
+	id, err := parse.ParseCrossTenantAccessPartnerIdentitySynchronizationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	partnerId := stable.NewPolicyCrossTenantAccessPolicyPartnerID(id.TenantId)
+
+	if _, err = client.DeleteCrossTenantAccessPolicyPartnerIdentitySynchronization(ctx, partnerId, crosstenantaccesspolicypartneridentitysynchronization.DefaultDeleteCrossTenantAccessPolicyPartnerIdentitySynchronizationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
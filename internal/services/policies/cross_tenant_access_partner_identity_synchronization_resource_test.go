@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartneridentitysynchronization"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type CrossTenantAccessPartnerIdentitySynchronizationResource struct{}
+
+func TestAccCrossTenantAccessPartnerIdentitySynchronization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_partner_identity_synchronization", "test")
+	r := CrossTenantAccessPartnerIdentitySynchronizationResource{}
+	tenantId := partnerTenantId(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, tenantId, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_sync_inbound_allowed").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPartnerIdentitySynchronization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_partner_identity_synchronization", "test")
+	r := CrossTenantAccessPartnerIdentitySynchronizationResource{}
+	tenantId := partnerTenantId(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, tenantId, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, tenantId, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_sync_inbound_allowed").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPartnerIdentitySynchronizationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.CrossTenantIdentitySyncClient
+
+	id, err := parse.ParseCrossTenantAccessPartnerIdentitySynchronizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	partnerId := stable.NewPolicyCrossTenantAccessPolicyPartnerID(id.TenantId)
+
+	resp, err := client.GetCrossTenantAccessPolicyPartnerIdentitySynchronization(ctx, partnerId, crosstenantaccesspolicypartneridentitysynchronization.DefaultGetCrossTenantAccessPolicyPartnerIdentitySynchronizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CrossTenantAccessPartnerIdentitySynchronizationResource) basic(data acceptance.TestData, tenantId string, allowed bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_partner" "test" {
+  tenant_id = "%[1]s"
+}
+
+resource "azuread_cross_tenant_access_partner_identity_synchronization" "test" {
+  tenant_id                 = azuread_cross_tenant_access_partner.test.tenant_id
+  display_name              = "acctest-SYNC-%[2]d"
+  user_sync_inbound_allowed = %[3]t
+}
+`, tenantId, data.RandomInteger, allowed)
+}
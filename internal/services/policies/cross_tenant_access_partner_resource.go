@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartner"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func crossTenantAccessPartnerResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: crossTenantAccessPartnerResourceCreate,
+		ReadContext:   crossTenantAccessPartnerResourceRead,
+		UpdateContext: crossTenantAccessPartnerResourceUpdate,
+		DeleteContext: crossTenantAccessPartnerResourceDelete,
+
+		CustomizeDiff: crossTenantAccessPartnerResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyCrossTenantAccessPolicyPartnerID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"tenant_id": {
+				Description:  "The tenant ID of the partner organization",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"automatic_user_consent": {
+				Description: "Determines whether user consent is automatically redeemed for B2B collaboration and B2B direct connect with the partner organization",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"inbound_allowed": {
+							Description: "Whether consent is automatically redeemed for users from the partner organization",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+
+						"outbound_allowed": {
+							Description: "Whether consent is automatically redeemed for users in your organization accessing the partner organization",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"b2b_collaboration_inbound": crossTenantAccessPolicyB2BSettingSchema("The configuration for users from the partner organization accessing your resources via B2B collaboration", false),
+
+			"b2b_collaboration_outbound": crossTenantAccessPolicyB2BSettingSchema("The configuration for users in your organization accessing resources in the partner organization via B2B collaboration", false),
+
+			"b2b_direct_connect_inbound": crossTenantAccessPolicyB2BSettingSchema("The configuration for users from the partner organization accessing your resources via B2B direct connect", false),
+
+			"b2b_direct_connect_outbound": crossTenantAccessPolicyB2BSettingSchema("The configuration for users in your organization accessing resources in the partner organization via B2B direct connect", false),
+
+			"inbound_trust": crossTenantAccessPolicyInboundTrustSchema(false),
+
+			"tenant_restrictions": crossTenantAccessPolicyB2BSettingSchema("The tenant restrictions for users in your organization accessing the partner organization on your network or devices", false),
+
+			"in_multi_tenant_organization": {
+				Description: "Whether the partner tenant is a member of a multi-tenant organization",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"service_provider": {
+				Description: "Whether the partner organization is a Cloud Service Provider for your organization",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func crossTenantAccessPartnerResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	// Tenant restrictions cannot be removed from an existing partner configuration, so the configuration must be replaced
+	if old, new := diff.GetChange("tenant_restrictions.#"); old.(int) > 0 && new.(int) == 0 {
+		if err := diff.ForceNew("tenant_restrictions"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func crossTenantAccessPartnerResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPartnerClient
+	tenantId := d.Get("tenant_id").(string)
+	id := stable.NewPolicyCrossTenantAccessPolicyPartnerID(tenantId)
+
+	resp, err := client.GetCrossTenantAccessPolicyPartner(ctx, id, crosstenantaccesspolicypartner.DefaultGetCrossTenantAccessPolicyPartnerOperationOptions())
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_cross_tenant_access_partner", id.ID())
+	}
+
+	properties := expandCrossTenantAccessPartner(d)
+	properties.TenantId = pointer.To(tenantId)
+
+	if _, err = client.CreateCrossTenantAccessPolicyPartner(ctx, properties, crosstenantaccesspolicypartner.DefaultCreateCrossTenantAccessPolicyPartnerOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not create %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	return crossTenantAccessPartnerResourceRead(ctx, d, meta)
+}
+
+func crossTenantAccessPartnerResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPartnerClient
+
+	id, err := stable.ParsePolicyCrossTenantAccessPolicyPartnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetCrossTenantAccessPolicyPartner(ctx, *id, crosstenantaccesspolicypartner.DefaultGetCrossTenantAccessPolicyPartnerOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	partner := resp.Model
+	if partner == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "automatic_user_consent", flattenCrossTenantAccessPartnerAutomaticUserConsent(partner.AutomaticUserConsentSettings))
+	tf.Set(d, "b2b_collaboration_inbound", flattenCrossTenantAccessPolicyB2BSetting(partner.B2bCollaborationInbound))
+	tf.Set(d, "b2b_collaboration_outbound", flattenCrossTenantAccessPolicyB2BSetting(partner.B2bCollaborationOutbound))
+	tf.Set(d, "b2b_direct_connect_inbound", flattenCrossTenantAccessPolicyB2BSetting(partner.B2bDirectConnectInbound))
+	tf.Set(d, "b2b_direct_connect_outbound", flattenCrossTenantAccessPolicyB2BSetting(partner.B2bDirectConnectOutbound))
+	tf.Set(d, "in_multi_tenant_organization", partner.IsInMultiTenantOrganization.GetOrZero())
+	tf.Set(d, "inbound_trust", flattenCrossTenantAccessPolicyInboundTrust(partner.InboundTrust))
+	tf.Set(d, "service_provider", partner.IsServiceProvider.GetOrZero())
+	tf.Set(d, "tenant_id", id.CrossTenantAccessPolicyConfigurationPartnerTenantId)
+	tf.Set(d, "tenant_restrictions", flattenCrossTenantAccessPolicyTenantRestrictions(partner.TenantRestrictions))
+
+	return nil
+}
+
+func crossTenantAccessPartnerResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPartnerClient
+
+	id, err := stable.ParsePolicyCrossTenantAccessPolicyPartnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	// B2B settings which are not specified are sent as null, so that they inherit the default configuration
+	properties := expandCrossTenantAccessPartner(d)
+
+	if _, err = client.UpdateCrossTenantAccessPolicyPartner(ctx, *id, properties, crosstenantaccesspolicypartner.DefaultUpdateCrossTenantAccessPolicyPartnerOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return crossTenantAccessPartnerResourceRead(ctx, d, meta)
+}
+
+func crossTenantAccessPartnerResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPartnerClient
+
+	id, err := stable.ParsePolicyCrossTenantAccessPolicyPartnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err = client.DeleteCrossTenantAccessPolicyPartner(ctx, *id, crosstenantaccesspolicypartner.DefaultDeleteCrossTenantAccessPolicyPartnerOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	// Wait for partner configuration to be deleted
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetCrossTenantAccessPolicyPartner(ctx, *id, crosstenantaccesspolicypartner.DefaultGetCrossTenantAccessPolicyPartnerOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandCrossTenantAccessPartner(d *pluginsdk.ResourceData) stable.CrossTenantAccessPolicyConfigurationPartner {
+	return stable.CrossTenantAccessPolicyConfigurationPartner{
+		AutomaticUserConsentSettings: expandCrossTenantAccessPartnerAutomaticUserConsent(d.Get("automatic_user_consent").([]interface{})),
+		B2bCollaborationInbound:      expandCrossTenantAccessPolicyB2BSetting(d.Get("b2b_collaboration_inbound").([]interface{})),
+		B2bCollaborationOutbound:     expandCrossTenantAccessPolicyB2BSetting(d.Get("b2b_collaboration_outbound").([]interface{})),
+		B2bDirectConnectInbound:      expandCrossTenantAccessPolicyB2BSetting(d.Get("b2b_direct_connect_inbound").([]interface{})),
+		B2bDirectConnectOutbound:     expandCrossTenantAccessPolicyB2BSetting(d.Get("b2b_direct_connect_outbound").([]interface{})),
+		InboundTrust:                 expandCrossTenantAccessPolicyInboundTrust(d.Get("inbound_trust").([]interface{})),
+		TenantRestrictions:           expandCrossTenantAccessPolicyTenantRestrictions(d.Get("tenant_restrictions").([]interface{})),
+	}
+}
+
+func expandCrossTenantAccessPartnerAutomaticUserConsent(in []interface{}) *stable.InboundOutboundPolicyConfiguration {
+	if len(in) == 0 || in[0] == nil {
+		settings := stable.InboundOutboundPolicyConfiguration{}
+		settings.InboundAllowed.SetNull()
+		settings.OutboundAllowed.SetNull()
+		return &settings
+	}
+
+	config := in[0].(map[string]interface{})
+
+	return &stable.InboundOutboundPolicyConfiguration{
+		InboundAllowed:  nullable.Value(config["inbound_allowed"].(bool)),
+		OutboundAllowed: nullable.Value(config["outbound_allowed"].(bool)),
+	}
+}
+
+func flattenCrossTenantAccessPartnerAutomaticUserConsent(in *stable.InboundOutboundPolicyConfiguration) []map[string]interface{} {
+	if in == nil || (in.InboundAllowed.Get() == nil && in.OutboundAllowed.Get() == nil) {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"inbound_allowed":  in.InboundAllowed.GetOrZero(),
+		"outbound_allowed": in.OutboundAllowed.GetOrZero(),
+	}}
+}
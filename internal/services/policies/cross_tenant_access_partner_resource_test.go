@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/crosstenantaccesspolicypartner"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type CrossTenantAccessPartnerResource struct{}
+
+// partnerTenantId returns the ID of a second tenant to configure as a partner organization
+func partnerTenantId(t *testing.T) string {
+	tenantId := os.Getenv("ARM_TENANT_ID_ALT")
+	if tenantId == "" {
+		t.Skip("`ARM_TENANT_ID_ALT` must be set to test cross-tenant access partner configurations")
+	}
+	return tenantId
+}
+
+func TestAccCrossTenantAccessPartner_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_partner", "test")
+	r := CrossTenantAccessPartnerResource{}
+	tenantId := partnerTenantId(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tenant_id").HasValue(tenantId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPartner_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_partner", "test")
+	r := CrossTenantAccessPartnerResource{}
+	tenantId := partnerTenantId(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("b2b_direct_connect_inbound.#").HasValue("1"),
+				check.That(data.ResourceName).Key("inbound_trust.0.mfa_accepted").HasValue("true"),
+				check.That(data.ResourceName).Key("automatic_user_consent.0.inbound_allowed").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPartner_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_partner", "test")
+	r := CrossTenantAccessPartnerResource{}
+	tenantId := partnerTenantId(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("b2b_direct_connect_inbound.#").HasValue("0"),
+				check.That(data.ResourceName).Key("inbound_trust.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPartnerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.CrossTenantAccessPartnerClient
+
+	id, err := stable.ParsePolicyCrossTenantAccessPolicyPartnerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCrossTenantAccessPolicyPartner(ctx, *id, crosstenantaccesspolicypartner.DefaultGetCrossTenantAccessPolicyPartnerOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CrossTenantAccessPartnerResource) basic(tenantId string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_partner" "test" {
+  tenant_id = "%[1]s"
+}
+`, tenantId)
+}
+
+func (CrossTenantAccessPartnerResource) complete(tenantId string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_partner" "test" {
+  tenant_id = "%[1]s"
+
+  automatic_user_consent {
+    inbound_allowed  = true
+    outbound_allowed = true
+  }
+
+  b2b_collaboration_outbound {
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  b2b_direct_connect_inbound {
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "Office365"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  b2b_direct_connect_outbound {
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "Office365"
+        target_type = "application"
+      }
+    }
+
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+  }
+
+  inbound_trust {
+    compliant_device_accepted = true
+    mfa_accepted              = true
+  }
+}
+`, tenantId)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+var _ sdk.DataSource = DirectoryRoleManagementPolicyDataSource{}
+
+type DirectoryRoleManagementPolicyDataSourceModel struct {
+	Description string `tfschema:"description"`
+	DisplayName string `tfschema:"display_name"`
+	RoleId      string `tfschema:"role_id"`
+}
+
+type DirectoryRoleManagementPolicyDataSource struct{}
+
+func (r DirectoryRoleManagementPolicyDataSource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"role_id": {
+			Description:  "The template ID of the directory role to which this policy applies",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyDataSource) Attributes() map[string]*schema.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description: "The display name of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"description": {
+			Description: "Description of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyDataSource) ModelObject() interface{} {
+	return &DirectoryRoleManagementPolicyDataSourceModel{}
+}
+
+func (r DirectoryRoleManagementPolicyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			policyClient := metadata.Client.Policies.RoleManagementPolicyClient
+			assignmentClient := metadata.Client.Policies.RoleManagementPolicyAssignmentClient
+
+			var model DirectoryRoleManagementPolicyDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policyId, err := getPolicyId(ctx, metadata, "DirectoryRole", "/", model.RoleId)
+			if err != nil {
+				return fmt.Errorf("determining Policy ID: %v", err)
+			}
+
+			id := stable.NewPolicyRoleManagementPolicyID(policyId.ID())
+
+			policyOptions := rolemanagementpolicy.GetRoleManagementPolicyOperationOptions{
+				Expand: &odata.Expand{
+					Relationship: "*",
+				},
+			}
+
+			policyResp, err := policyClient.GetRoleManagementPolicy(ctx, id, policyOptions)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			policy := policyResp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: API error, model was nil", id)
+			}
+
+			options := rolemanagementpolicyassignment.ListRoleManagementPolicyAssignmentsOperationOptions{
+				Filter: pointer.To(fmt.Sprintf("scopeType eq 'DirectoryRole' and scopeId eq '/' and policyId eq '%s'", odata.EscapeSingleQuote(id.UnifiedRoleManagementPolicyId))),
+			}
+			resp, err := assignmentClient.ListRoleManagementPolicyAssignments(ctx, options)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: expected 1 assignment, got nil result", id)
+			}
+			if len(*resp.Model) != 1 {
+				return fmt.Errorf("retrieving %s: expected 1 assignment, got %d", id, len(*resp.Model))
+			}
+
+			assignment := (*resp.Model)[0]
+
+			state := DirectoryRoleManagementPolicyDataSourceModel{
+				Description: pointer.From(policy.Description),
+				DisplayName: pointer.From(policy.DisplayName),
+				RoleId:      assignment.RoleDefinitionId.GetOrZero(),
+			}
+
+			metadata.ResourceData.SetId(id.ID())
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyDataSource) ResourceType() string {
+	return "azuread_directory_role_management_policy"
+}
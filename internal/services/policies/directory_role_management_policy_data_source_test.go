@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryRoleManagementPolicyDataSource struct{}
+
+func TestDirectoryRoleManagementPolicyDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_role_management_policy", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DirectoryRoleManagementPolicyDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("role_id").Exists(),
+			),
+		},
+	})
+}
+
+func (DirectoryRoleManagementPolicyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_role_management_policy" "test" {
+  role_id = azuread_directory_role_management_policy.test.role_id
+}
+`, DirectoryRoleManagementPolicyResource{}.basic(data))
+}
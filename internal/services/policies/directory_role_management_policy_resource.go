@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type DirectoryRoleManagementPolicyModel struct {
+	Description             string                                        `tfschema:"description"`
+	DisplayName             string                                        `tfschema:"display_name"`
+	RoleId                  string                                        `tfschema:"role_id"`
+	ActiveAssignmentRules   []RoleManagementPolicyActiveAssignmentRules   `tfschema:"active_assignment_rules"`
+	EligibleAssignmentRules []RoleManagementPolicyEligibleAssignmentRules `tfschema:"eligible_assignment_rules"`
+	ActivationRules         []RoleManagementPolicyActivationRules         `tfschema:"activation_rules"`
+	NotificationRules       []RoleManagementPolicyNotificationEvents      `tfschema:"notification_rules"`
+}
+
+var _ sdk.ResourceWithUpdate = DirectoryRoleManagementPolicyResource{}
+
+type DirectoryRoleManagementPolicyResource struct{}
+
+func (r DirectoryRoleManagementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateDirectoryRoleRoleManagementPolicyID
+}
+
+func (r DirectoryRoleManagementPolicyResource) ResourceType() string {
+	return "azuread_directory_role_management_policy"
+}
+
+func (r DirectoryRoleManagementPolicyResource) ModelObject() interface{} {
+	return &DirectoryRoleManagementPolicyModel{}
+}
+
+func (r DirectoryRoleManagementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := map[string]*pluginsdk.Schema{
+		"role_id": {
+			Description:  "The template ID of the directory role to which this policy applies",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+
+	for k, v := range roleManagementPolicyRuleArguments() {
+		arguments[k] = v
+	}
+
+	return arguments
+}
+
+func (r DirectoryRoleManagementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description: "The display name of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"description": {
+			Description: "Description of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.RoleManagementPolicyClient
+
+			var model DirectoryRoleManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Fetch the existing policy, as they already exist
+			policyId, err := getPolicyId(ctx, metadata, "DirectoryRole", "/", model.RoleId)
+			if err != nil {
+				return fmt.Errorf("parsing policy ID: %v", err)
+			}
+
+			id := stable.NewPolicyRoleManagementPolicyID(policyId.ID())
+
+			options := rolemanagementpolicy.GetRoleManagementPolicyOperationOptions{
+				Expand: &odata.Expand{
+					Relationship: "*",
+				},
+			}
+
+			resp, err := client.GetRoleManagementPolicy(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			roleManagementPolicy := resp.Model
+			if roleManagementPolicy == nil {
+				return fmt.Errorf("retrieving %s: API error, result was nil", id)
+			}
+
+			policyUpdate, err := buildDirectoryRolePolicyForUpdate(pointer.To(metadata), roleManagementPolicy)
+			if err != nil {
+				return fmt.Errorf("building update request: %v", err)
+			}
+
+			if _, err = client.UpdateRoleManagementPolicy(ctx, id, *policyUpdate, rolemanagementpolicy.DefaultUpdateRoleManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %v", id, err)
+			}
+
+			// Update the ID as it changes on modification
+			policyId, err = getPolicyId(ctx, metadata, "DirectoryRole", "/", model.RoleId)
+			if err != nil {
+				return fmt.Errorf("parsing policy ID: %v", err)
+			}
+
+			metadata.SetID(policyId)
+
+			return nil
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.RoleManagementPolicyClient
+			assignmentClient := metadata.Client.Policies.RoleManagementPolicyAssignmentClient
+
+			policyId, err := parse.ParseRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("parsing policy ID: %v", err)
+			}
+
+			var model DirectoryRoleManagementPolicyModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := stable.NewPolicyRoleManagementPolicyID(policyId.ID())
+
+			policyOptions := rolemanagementpolicy.GetRoleManagementPolicyOperationOptions{
+				Expand: &odata.Expand{
+					Relationship: "*",
+				},
+			}
+
+			policyResp, err := client.GetRoleManagementPolicy(ctx, id, policyOptions)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			policy := policyResp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: API error, model was nil", id)
+			}
+
+			assignmentOptions := rolemanagementpolicyassignment.ListRoleManagementPolicyAssignmentsOperationOptions{
+				Filter: pointer.To(fmt.Sprintf("scopeType eq 'DirectoryRole' and scopeId eq '/' and policyId eq '%s'", id.UnifiedRoleManagementPolicyId)),
+			}
+			resp, err := assignmentClient.ListRoleManagementPolicyAssignments(ctx, assignmentOptions)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: expected 1 assignment, got nil result", id)
+			}
+			if len(*resp.Model) != 1 {
+				return fmt.Errorf("retrieving %s: expected 1 assignment, got %d", id, len(*resp.Model))
+			}
+
+			assignment := (*resp.Model)[0]
+
+			model.Description = pointer.From(policy.Description)
+			model.DisplayName = pointer.From(policy.DisplayName)
+			model.RoleId = assignment.RoleDefinitionId.GetOrZero()
+
+			rules := roleManagementPolicyRules{
+				ActiveAssignmentRules:   model.ActiveAssignmentRules,
+				EligibleAssignmentRules: model.EligibleAssignmentRules,
+				ActivationRules:         model.ActivationRules,
+				NotificationRules:       model.NotificationRules,
+			}
+			if err = flattenRoleManagementPolicyRules(policy.Rules, &rules); err != nil {
+				return fmt.Errorf("flattening rules for %s: %v", id, err)
+			}
+
+			model.ActiveAssignmentRules = rules.ActiveAssignmentRules
+			model.EligibleAssignmentRules = rules.EligibleAssignmentRules
+			model.ActivationRules = rules.ActivationRules
+			model.NotificationRules = rules.NotificationRules
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.RoleManagementPolicyClient
+
+			policyId, err := parse.ParseRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("parsing policy ID: %v", err)
+			}
+
+			var model DirectoryRoleManagementPolicyModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := stable.NewPolicyRoleManagementPolicyID(policyId.ID())
+
+			policyOptions := rolemanagementpolicy.GetRoleManagementPolicyOperationOptions{
+				Expand: &odata.Expand{
+					Relationship: "*",
+				},
+			}
+
+			policyResp, err := client.GetRoleManagementPolicy(ctx, id, policyOptions)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			policy := policyResp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: API error, model was nil", id)
+			}
+
+			policyUpdate, err := buildDirectoryRolePolicyForUpdate(pointer.To(metadata), policy)
+			if err != nil {
+				return fmt.Errorf("building update request: %v", err)
+			}
+
+			if _, err = client.UpdateRoleManagementPolicy(ctx, id, *policyUpdate, rolemanagementpolicy.DefaultUpdateRoleManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %v", id, err)
+			}
+
+			// Update the ID as it changes on modification
+			policyId, err = getPolicyId(ctx, metadata, "DirectoryRole", "/", model.RoleId)
+			if err != nil {
+				return fmt.Errorf("parsing policy ID: %v", err)
+			}
+
+			metadata.SetID(policyId)
+
+			return nil
+		},
+	}
+}
+
+func (r DirectoryRoleManagementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Policy cannot be destroyed, so this is a noop
+			return nil
+		},
+	}
+}
+
+func buildDirectoryRolePolicyForUpdate(metadata *sdk.ResourceMetaData, policy *stable.UnifiedRoleManagementPolicy) (*stable.UnifiedRoleManagementPolicy, error) {
+	var model DirectoryRoleManagementPolicyModel
+	if err := metadata.Decode(&model); err != nil {
+		return nil, fmt.Errorf("decoding: %+v", err)
+	}
+
+	updatedRules, err := expandRoleManagementPolicyRules(metadata.ResourceData, roleManagementPolicyRules{
+		ActiveAssignmentRules:   model.ActiveAssignmentRules,
+		EligibleAssignmentRules: model.EligibleAssignmentRules,
+		ActivationRules:         model.ActivationRules,
+		NotificationRules:       model.NotificationRules,
+	}, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stable.UnifiedRoleManagementPolicy{
+		Id:        policy.Id,
+		Rules:     pointer.To(updatedRules),
+		ScopeId:   "/",
+		ScopeType: "DirectoryRole",
+	}, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type DirectoryRoleManagementPolicyResource struct{}
+
+func TestDirectoryRoleManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_management_policy", "test")
+	r := DirectoryRoleManagementPolicyResource{}
+
+	// Ignore the dangling resource post-test as directory role policies cannot be deleted
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("activation_rules.0.maximum_duration").HasValue("PT4H"),
+				check.That(data.ResourceName).Key("active_assignment_rules.0.expire_after").HasValue("P180D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestDirectoryRoleManagementPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_management_policy", "test")
+	r := DirectoryRoleManagementPolicyResource{}
+
+	// Ignore the dangling resource post-test as directory role policies cannot be deleted
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_rules.0.require_approval").HasValue("true"),
+				check.That(data.ResourceName).Key("activation_rules.0.require_multifactor_authentication").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DirectoryRoleManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.RoleManagementPolicyClient
+	policyId, err := parse.ParseRoleManagementPolicyID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse policy ID: %v", err)
+	}
+
+	id := stable.NewPolicyRoleManagementPolicyID(policyId.ID())
+
+	policyOptions := rolemanagementpolicy.GetRoleManagementPolicyOperationOptions{
+		Expand: &odata.Expand{
+			Relationship: "*",
+		},
+	}
+
+	policyResp, err := client.GetRoleManagementPolicy(ctx, id, policyOptions)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	if policyResp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: API error, model was nil", id)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DirectoryRoleManagementPolicyResource) template() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Reports Reader"
+}
+`
+}
+
+func (r DirectoryRoleManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_management_policy" "test" {
+  role_id = azuread_directory_role.test.template_id
+
+  eligible_assignment_rules {
+    expiration_required = true
+    expire_after        = "P365D"
+  }
+
+  active_assignment_rules {
+    expiration_required = true
+    expire_after        = "P180D"
+  }
+
+  activation_rules {
+    maximum_duration = "PT4H"
+  }
+}
+`, r.template())
+}
+
+func (r DirectoryRoleManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "approver" {
+  user_principal_name = "acctestUser-%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+}
+
+resource "azuread_directory_role_management_policy" "test" {
+  role_id = azuread_directory_role.test.template_id
+
+  eligible_assignment_rules {
+    expiration_required = true
+    expire_after        = "P90D"
+  }
+
+  active_assignment_rules {
+    expiration_required                = true
+    expire_after                       = "P30D"
+    require_multifactor_authentication = true
+    require_justification              = true
+  }
+
+  activation_rules {
+    maximum_duration                   = "PT2H"
+    require_multifactor_authentication = true
+    require_justification              = true
+    require_approval                   = true
+
+    approval_stage {
+      primary_approver {
+        object_id = azuread_user.approver.object_id
+        type      = "singleUser"
+      }
+    }
+  }
+
+  notification_rules {
+    eligible_activations {
+      admin_notifications {
+        notification_level    = "Critical"
+        default_recipients    = true
+        additional_recipients = ["someone@example.com"]
+      }
+    }
+  }
+}
+`, r.template(), data.RandomInteger, data.RandomPassword)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type CrossTenantAccessPartnerIdentitySynchronizationId struct {
+	TenantId string
+}
+
+func NewCrossTenantAccessPartnerIdentitySynchronizationID(tenantId string) *CrossTenantAccessPartnerIdentitySynchronizationId {
+	return &CrossTenantAccessPartnerIdentitySynchronizationId{
+		TenantId: tenantId,
+	}
+}
+
+// ParseCrossTenantAccessPartnerIdentitySynchronizationID parses 'input' into a CrossTenantAccessPartnerIdentitySynchronizationId
+func ParseCrossTenantAccessPartnerIdentitySynchronizationID(input string) (*CrossTenantAccessPartnerIdentitySynchronizationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&CrossTenantAccessPartnerIdentitySynchronizationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &CrossTenantAccessPartnerIdentitySynchronizationId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateCrossTenantAccessPartnerIdentitySynchronizationID checks that 'input' can be parsed as a Cross Tenant Access Partner Identity Synchronization ID
+func ValidateCrossTenantAccessPartnerIdentitySynchronizationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseCrossTenantAccessPartnerIdentitySynchronizationID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.TenantId, "ID")
+}
+
+func (id *CrossTenantAccessPartnerIdentitySynchronizationId) ID() string {
+	fmtString := "/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization"
+	return fmt.Sprintf(fmtString, id.TenantId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *CrossTenantAccessPartnerIdentitySynchronizationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("crossTenantAccessPolicy", "crossTenantAccessPolicy", "crossTenantAccessPolicy"),
+		resourceids.StaticSegment("partners", "partners", "partners"),
+		resourceids.UserSpecifiedSegment("tenantId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("identitySynchronization", "identitySynchronization", "identitySynchronization"),
+	}
+}
+
+func (id *CrossTenantAccessPartnerIdentitySynchronizationId) String() string {
+	return fmt.Sprintf("Cross Tenant Access Partner Identity Synchronization (Tenant ID: %q)", id.TenantId)
+}
+
+func (id *CrossTenantAccessPartnerIdentitySynchronizationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.TenantId, ok = input.Parsed["tenantId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tenantId", input)
+	}
+
+	return nil
+}
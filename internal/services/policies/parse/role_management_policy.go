@@ -78,6 +78,7 @@ func ValidateDirectoryRoleManagementPolicyID(input interface{}, key string) (war
 	id, err := ParseRoleManagementPolicyID(v)
 	if err != nil {
 		errors = append(errors, err)
+		return
 	}
 
 	if id.ScopeType != scopeTypeDirectory {
@@ -97,6 +98,7 @@ func ValidateDirectoryRoleRoleManagementPolicyID(input interface{}, key string)
 	id, err := ParseRoleManagementPolicyID(v)
 	if err != nil {
 		errors = append(errors, err)
+		return
 	}
 
 	if id.ScopeType != scopeTypeDirectoryRole {
@@ -116,6 +118,7 @@ func ValidateGroupRoleManagementPolicyID(input interface{}, key string) (warning
 	id, err := ParseRoleManagementPolicyID(v)
 	if err != nil {
 		errors = append(errors, err)
+		return
 	}
 
 	if id.ScopeType != scopeTypeGroup {
@@ -14,11 +14,11 @@ import (
 )
 
 // There isn't a reliable way to get the policy ID from the policy API, as the policy ID changes with each modification
-func getPolicyId(ctx context.Context, metadata sdk.ResourceMetaData, scopeId, roleDefinitionId string) (*parse.RoleManagementPolicyId, error) {
+func getPolicyId(ctx context.Context, metadata sdk.ResourceMetaData, scopeType, scopeId, roleDefinitionId string) (*parse.RoleManagementPolicyId, error) {
 	client := metadata.Client.Policies.RoleManagementPolicyAssignmentClient
 
 	options := rolemanagementpolicyassignment.ListRoleManagementPolicyAssignmentsOperationOptions{
-		Filter: pointer.To(fmt.Sprintf("scopeType eq 'Group' and scopeId eq '%s' and roleDefinitionId eq '%s'", scopeId, roleDefinitionId)),
+		Filter: pointer.To(fmt.Sprintf("scopeType eq '%s' and scopeId eq '%s' and roleDefinitionId eq '%s'", scopeType, scopeId, roleDefinitionId)),
 	}
 
 	resp, err := client.ListRoleManagementPolicyAssignments(ctx, options)
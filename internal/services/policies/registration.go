@@ -29,20 +29,31 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_authentication_strength_policies": authenticationStrengthPoliciesDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_authentication_strength_policy": authenticationStrengthPolicyResource(),
-		"azuread_claims_mapping_policy":          claimsMappingPolicyResource(),
+		"azuread_authentication_method_policy":                         authenticationMethodPolicyResource(),
+		"azuread_authentication_method_registration_campaign":          authenticationMethodRegistrationCampaignResource(),
+		"azuread_authentication_strength_policy":                       authenticationStrengthPolicyResource(),
+		"azuread_authorization_policy":                                 authorizationPolicyResource(),
+		"azuread_claims_mapping_policy":                                claimsMappingPolicyResource(),
+		"azuread_continuous_access_evaluation_policy":                  continuousAccessEvaluationPolicyResource(),
+		"azuread_cross_tenant_access_default":                          crossTenantAccessDefaultResource(),
+		"azuread_cross_tenant_access_partner":                          crossTenantAccessPartnerResource(),
+		"azuread_cross_tenant_access_partner_identity_synchronization": crossTenantAccessPartnerIdentitySynchronizationResource(),
+		"azuread_security_defaults":                                    securityDefaultsResource(),
 	}
 }
 
 // DataSources returns the typed DataSources supported by this service
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		DirectoryRoleManagementPolicyDataSource{},
 		GroupRoleManagementPolicyDataSource{},
 	}
 }
@@ -50,6 +61,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		DirectoryRoleManagementPolicyResource{},
 		GroupRoleManagementPolicyResource{},
 	}
 }
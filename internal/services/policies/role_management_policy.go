@@ -0,0 +1,788 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type RoleManagementPolicyActiveAssignmentRules struct {
+	ExpirationRequired     bool   `tfschema:"expiration_required"`
+	ExpireAfter            string `tfschema:"expire_after"`
+	RequireJustification   bool   `tfschema:"require_justification"`
+	RequireMultiFactorAuth bool   `tfschema:"require_multifactor_authentication"`
+	RequireTicketInfo      bool   `tfschema:"require_ticket_info"`
+}
+
+type RoleManagementPolicyEligibleAssignmentRules struct {
+	ExpirationRequired bool   `tfschema:"expiration_required"`
+	ExpireAfter        string `tfschema:"expire_after"`
+}
+
+type RoleManagementPolicyActivationRules struct {
+	ApprovalStages                  []RoleManagementPolicyApprovalStage `tfschema:"approval_stage"`
+	MaximumDuration                 string                              `tfschema:"maximum_duration"`
+	RequireApproval                 bool                                `tfschema:"require_approval"`
+	RequireConditionalAccessContext string                              `tfschema:"required_conditional_access_authentication_context"`
+	RequireJustification            bool                                `tfschema:"require_justification"`
+	RequireMultiFactorAuth          bool                                `tfschema:"require_multifactor_authentication"`
+	RequireTicketInfo               bool                                `tfschema:"require_ticket_info"`
+}
+
+type RoleManagementPolicyApprovalStage struct {
+	PrimaryApprovers []RoleManagementPolicyApprover `tfschema:"primary_approver"`
+}
+
+type RoleManagementPolicyApprover struct {
+	ID   string `tfschema:"object_id"`
+	Type string `tfschema:"type"`
+}
+
+type RoleManagementPolicyNotificationEvents struct {
+	ActiveAssignments   []RoleManagementPolicyNotificationRule `tfschema:"active_assignments"`
+	EligibleActivations []RoleManagementPolicyNotificationRule `tfschema:"eligible_activations"`
+	EligibleAssignments []RoleManagementPolicyNotificationRule `tfschema:"eligible_assignments"`
+}
+
+type RoleManagementPolicyNotificationRule struct {
+	AdminNotifications    []RoleManagementPolicyNotificationSettings `tfschema:"admin_notifications"`
+	ApproverNotifications []RoleManagementPolicyNotificationSettings `tfschema:"approver_notifications"`
+	AssigneeNotifications []RoleManagementPolicyNotificationSettings `tfschema:"assignee_notifications"`
+}
+
+type RoleManagementPolicyNotificationSettings struct {
+	AdditionalRecipients []string `tfschema:"additional_recipients"`
+	DefaultRecipients    bool     `tfschema:"default_recipients"`
+	NotificationLevel    string   `tfschema:"notification_level"`
+}
+
+// roleManagementPolicyRules holds the rule blocks which are shared by role management policy resources
+type roleManagementPolicyRules struct {
+	ActiveAssignmentRules   []RoleManagementPolicyActiveAssignmentRules
+	EligibleAssignmentRules []RoleManagementPolicyEligibleAssignmentRules
+	ActivationRules         []RoleManagementPolicyActivationRules
+	NotificationRules       []RoleManagementPolicyNotificationEvents
+}
+
+// roleManagementPolicyRuleArguments returns the schema for the rule blocks which are shared by role management policy resources
+func roleManagementPolicyRuleArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"eligible_assignment_rules": {
+			Description: "The rules for eligible assignment of the policy",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expiration_required": {
+						Description: "Must the assignment have an expiry date",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"expire_after": {
+						Description:  "The duration after which assignments expire",
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"P15D", "P30D", "P90D", "P180D", "P365D"}, false),
+					},
+				},
+			},
+		},
+
+		"active_assignment_rules": {
+			Description: "The rules for active assignment of the policy",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expiration_required": {
+						Description: "Must the assignment have an expiry date",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"expire_after": {
+						Description:  "The duration after which assignments expire",
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"P15D", "P30D", "P90D", "P180D", "P365D"}, false),
+					},
+
+					"require_multifactor_authentication": {
+						Description: "Whether multi-factor authentication is required to make an assignment",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"require_justification": {
+						Description: "Whether a justification is required to make an assignment",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"require_ticket_info": {
+						Description: "Whether ticket information is required to make an assignment",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"activation_rules": {
+			Description: "The activation rules of the policy",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"maximum_duration": {
+						Description: "The time after which the an activation can be valid for",
+						Type:        pluginsdk.TypeString,
+						Optional:    true,
+						Computed:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"PT30M", "PT1H", "PT1H30M", "PT2H", "PT2H30M", "PT3H", "PT3H30M", "PT4H", "PT4H30M", "PT5H", "PT5H30M", "PT6H",
+							"PT6H30M", "PT7H", "PT7H30M", "PT8H", "PT8H30M", "PT9H", "PT9H30M", "PT10H", "PT10H30M", "PT11H", "PT11H30M", "PT12H",
+							"PT12H30M", "PT13H", "PT13H30M", "PT14H", "PT14H30M", "PT15H", "PT15H30M", "PT16H", "PT16H30M", "PT17H", "PT17H30M", "PT18H",
+							"PT18H30M", "PT19H", "PT19H30M", "PT20H", "PT20H30M", "PT21H", "PT21H30M", "PT22H", "PT22H30M", "PT23H", "PT23H30M", "P1D",
+						}, false),
+					},
+
+					"require_approval": {
+						Description: "Whether an approval is required for activation",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"approval_stage": {
+						Description: "The approval stages for the activation",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"primary_approver": {
+									Description: "The IDs of the users or groups who can approve the activation",
+									Type:        pluginsdk.TypeSet,
+									Required:    true,
+									MinItems:    1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"object_id": {
+												Description:  "The ID of the object to act as an approver",
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.IsUUID,
+											},
+
+											"type": {
+												Description:  "The type of object acting as an approver",
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringInSlice([]string{"singleUser", "groupMembers"}, false),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"required_conditional_access_authentication_context": {
+						Description:   "Whether a conditional access context is required during activation",
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Computed:      true,
+						ConflictsWith: []string{"activation_rules.0.require_multifactor_authentication"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"require_multifactor_authentication": {
+						Description:   "Whether multi-factor authentication is required during activation",
+						Type:          pluginsdk.TypeBool,
+						Optional:      true,
+						Computed:      true,
+						ConflictsWith: []string{"activation_rules.0.required_conditional_access_authentication_context"},
+					},
+
+					"require_justification": {
+						Description: "Whether a justification is required during activation",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"require_ticket_info": {
+						Description: "Whether ticket information is required during activation",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"notification_rules": {
+			Description: "The notification rules of the policy",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"active_assignments": {
+						Description: "Notifications about active assignments",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem: &pluginsdk.Resource{
+							Schema: notificationRuleSchema(),
+						},
+					},
+					"eligible_activations": {
+						Description: "Notifications about activations of eligible assignments",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem: &pluginsdk.Resource{
+							Schema: notificationRuleSchema(),
+						},
+					},
+					"eligible_assignments": {
+						Description: "Notifications about eligible assignments",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem: &pluginsdk.Resource{
+							Schema: notificationRuleSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenRoleManagementPolicyRules populates the rule blocks from the rules of a role management policy
+func flattenRoleManagementPolicyRules(in *[]stable.UnifiedRoleManagementPolicyRule, rules *roleManagementPolicyRules) error {
+	if len(rules.EligibleAssignmentRules) == 0 {
+		rules.EligibleAssignmentRules = make([]RoleManagementPolicyEligibleAssignmentRules, 1)
+	}
+	if len(rules.ActiveAssignmentRules) == 0 {
+		rules.ActiveAssignmentRules = make([]RoleManagementPolicyActiveAssignmentRules, 1)
+	}
+	if len(rules.ActivationRules) == 0 {
+		rules.ActivationRules = make([]RoleManagementPolicyActivationRules, 1)
+	}
+	if len(rules.NotificationRules) == 0 {
+		rules.NotificationRules = make([]RoleManagementPolicyNotificationEvents, 1)
+	}
+	if len(rules.NotificationRules[0].EligibleActivations) == 0 {
+		rules.NotificationRules[0].EligibleActivations = make([]RoleManagementPolicyNotificationRule, 1)
+	}
+	if len(rules.NotificationRules[0].ActiveAssignments) == 0 {
+		rules.NotificationRules[0].ActiveAssignments = make([]RoleManagementPolicyNotificationRule, 1)
+	}
+	if len(rules.NotificationRules[0].EligibleAssignments) == 0 {
+		rules.NotificationRules[0].EligibleAssignments = make([]RoleManagementPolicyNotificationRule, 1)
+	}
+
+	if in != nil {
+		for _, rule := range *in {
+			switch pointer.From(rule.UnifiedRoleManagementPolicyRule().Id) {
+			case "Approval_EndUser_Assignment":
+				rules.ActivationRules[0].RequireApproval = rule.(stable.UnifiedRoleManagementPolicyApprovalRule).Setting.IsApprovalRequired.GetOrZero()
+				primaryApprovers := make([]RoleManagementPolicyApprover, 0)
+
+				if rule.(stable.UnifiedRoleManagementPolicyApprovalRule).Setting != nil && rule.(stable.UnifiedRoleManagementPolicyApprovalRule).Setting.ApprovalStages != nil {
+					if approvers := (*rule.(stable.UnifiedRoleManagementPolicyApprovalRule).Setting.ApprovalStages)[0].PrimaryApprovers; approvers != nil {
+						for _, approver := range *approvers {
+							switch {
+							case pointer.From(approver.SubjectSet().ODataType) == "#microsoft.graph.singleUser":
+								primaryApprovers = append(primaryApprovers, RoleManagementPolicyApprover{
+									ID:   approver.(stable.SingleUser).UserId.GetOrZero(),
+									Type: "singleUser",
+								})
+							case pointer.From(approver.SubjectSet().ODataType) == "#microsoft.graph.groupMembers":
+								primaryApprovers = append(primaryApprovers, RoleManagementPolicyApprover{
+									ID:   approver.(stable.GroupMembers).GroupId.GetOrZero(),
+									Type: "groupMembers",
+								})
+							default:
+								return fmt.Errorf("unknown approver type: %s", *approver.SubjectSet().ODataType)
+							}
+						}
+					}
+				}
+
+				rules.ActivationRules[0].ApprovalStages = []RoleManagementPolicyApprovalStage{{PrimaryApprovers: primaryApprovers}}
+
+			case "AuthenticationContext_EndUser_Assignment":
+				if rule.(stable.UnifiedRoleManagementPolicyAuthenticationContextRule).ClaimValue.GetOrZero() != "" {
+					rules.ActivationRules[0].RequireConditionalAccessContext = rule.(stable.UnifiedRoleManagementPolicyAuthenticationContextRule).ClaimValue.GetOrZero()
+				}
+
+			case "Enablement_Admin_Assignment":
+				rules.ActiveAssignmentRules[0].RequireMultiFactorAuth = false
+				rules.ActiveAssignmentRules[0].RequireJustification = false
+
+				if enabledRules := rule.(stable.UnifiedRoleManagementPolicyEnablementRule).EnabledRules; enabledRules != nil {
+					for _, enabledRule := range *enabledRules {
+						switch enabledRule {
+						case "MultiFactorAuthentication":
+							rules.ActiveAssignmentRules[0].RequireMultiFactorAuth = true
+						case "Justification":
+							rules.ActiveAssignmentRules[0].RequireJustification = true
+						}
+					}
+				}
+
+			case "Enablement_EndUser_Assignment":
+				rules.ActivationRules[0].RequireMultiFactorAuth = false
+				rules.ActivationRules[0].RequireJustification = false
+				rules.ActivationRules[0].RequireTicketInfo = false
+
+				if enabledRules := rule.(stable.UnifiedRoleManagementPolicyEnablementRule).EnabledRules; enabledRules != nil {
+					for _, enabledRule := range *enabledRules {
+						switch enabledRule {
+						case "MultiFactorAuthentication":
+							rules.ActivationRules[0].RequireMultiFactorAuth = true
+						case "Justification":
+							rules.ActivationRules[0].RequireJustification = true
+						case "Ticketing":
+							rules.ActivationRules[0].RequireTicketInfo = true
+						}
+					}
+				}
+
+			case "Expiration_Admin_Eligibility":
+				rules.EligibleAssignmentRules[0].ExpirationRequired = rule.(stable.UnifiedRoleManagementPolicyExpirationRule).IsExpirationRequired.GetOrZero()
+				rules.EligibleAssignmentRules[0].ExpireAfter = rule.(stable.UnifiedRoleManagementPolicyExpirationRule).MaximumDuration.GetOrZero()
+
+			case "Expiration_Admin_Assignment":
+				rules.ActiveAssignmentRules[0].ExpirationRequired = rule.(stable.UnifiedRoleManagementPolicyExpirationRule).IsExpirationRequired.GetOrZero()
+				rules.ActiveAssignmentRules[0].ExpireAfter = rule.(stable.UnifiedRoleManagementPolicyExpirationRule).MaximumDuration.GetOrZero()
+
+			case "Expiration_EndUser_Assignment":
+				rules.ActivationRules[0].MaximumDuration = rule.(stable.UnifiedRoleManagementPolicyExpirationRule).MaximumDuration.GetOrZero()
+
+			case "Notification_Admin_Admin_Assignment":
+				rules.NotificationRules[0].ActiveAssignments[0].AdminNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Admin_Admin_Eligibility":
+				rules.NotificationRules[0].EligibleAssignments[0].AdminNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Admin_EndUser_Assignment":
+				rules.NotificationRules[0].EligibleActivations[0].AdminNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Approver_Admin_Assignment":
+				rules.NotificationRules[0].ActiveAssignments[0].ApproverNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Approver_Admin_Eligibility":
+				rules.NotificationRules[0].EligibleAssignments[0].ApproverNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Approver_EndUser_Assignment":
+				rules.NotificationRules[0].EligibleActivations[0].ApproverNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Requestor_Admin_Assignment":
+				rules.NotificationRules[0].ActiveAssignments[0].AssigneeNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Requestor_Admin_Eligibility":
+				rules.NotificationRules[0].EligibleAssignments[0].AssigneeNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+
+			case "Notification_Requestor_EndUser_Assignment":
+				rules.NotificationRules[0].EligibleActivations[0].AssigneeNotifications = []RoleManagementPolicyNotificationSettings{
+					flattenNotificationSettings(rule.(stable.UnifiedRoleManagementPolicyNotificationRule)),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandRoleManagementPolicyRules returns the rules which have changed, retaining the targets of the existing rules
+func expandRoleManagementPolicyRules(d *pluginsdk.ResourceData, rules roleManagementPolicyRules, policy *stable.UnifiedRoleManagementPolicy) ([]stable.UnifiedRoleManagementPolicyRule, error) {
+	// Take the slice of rules and convert it to a map with the ID as the key
+	policyRules := make(map[string]stable.UnifiedRoleManagementPolicyRule)
+	for _, rule := range pointer.From(policy.Rules) {
+		id := rule.UnifiedRoleManagementPolicyRule().Id
+		if id == nil {
+			continue
+		}
+		policyRules[*id] = rule
+	}
+	updatedRules := make([]stable.UnifiedRoleManagementPolicyRule, 0)
+
+	if d.HasChange("eligible_assignment_rules") {
+		rule := stable.UnifiedRoleManagementPolicyExpirationRule{
+			Id:                   pointer.To("Expiration_Admin_Eligibility"),
+			IsExpirationRequired: nullable.Value(rules.EligibleAssignmentRules[0].ExpirationRequired),
+			MaximumDuration:      nullable.NoZero(rules.EligibleAssignmentRules[0].ExpireAfter),
+		}
+
+		if existingRule, ok := policyRules["Expiration_Admin_Eligibility"].(stable.UnifiedRoleManagementPolicyExpirationRule); ok {
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("active_assignment_rules.0.require_multifactor_authentication") ||
+		d.HasChange("active_assignment_rules.0.require_justification") {
+
+		enabledRules := make([]string, 0)
+		if rules.ActiveAssignmentRules[0].RequireMultiFactorAuth {
+			enabledRules = append(enabledRules, "MultiFactorAuthentication")
+		}
+		if rules.ActiveAssignmentRules[0].RequireJustification {
+			enabledRules = append(enabledRules, "Justification")
+		}
+		if rules.ActiveAssignmentRules[0].RequireTicketInfo {
+			enabledRules = append(enabledRules, "Ticketing")
+		}
+
+		rule := stable.UnifiedRoleManagementPolicyEnablementRule{
+			Id:           pointer.To("Enablement_Admin_Assignment"),
+			EnabledRules: &enabledRules,
+		}
+
+		if existingRule, ok := policyRules["Enablement_Admin_Assignment"].(stable.UnifiedRoleManagementPolicyEnablementRule); ok {
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("active_assignment_rules.0.expiration_required") ||
+		d.HasChange("active_assignment_rules.0.expire_after") {
+
+		rule := stable.UnifiedRoleManagementPolicyExpirationRule{
+			Id:                   pointer.To("Expiration_Admin_Assignment"),
+			IsExpirationRequired: nullable.Value(rules.ActiveAssignmentRules[0].ExpirationRequired),
+			MaximumDuration:      nullable.Value(rules.ActiveAssignmentRules[0].ExpireAfter),
+		}
+
+		if existingRule, ok := policyRules["Expiration_Admin_Assignment"].(stable.UnifiedRoleManagementPolicyExpirationRule); ok {
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("activation_rules.0.maximum_duration") {
+		rule := stable.UnifiedRoleManagementPolicyExpirationRule{
+			Id:              pointer.To("Expiration_EndUser_Assignment"),
+			MaximumDuration: nullable.Value(rules.ActivationRules[0].MaximumDuration),
+		}
+
+		if existingRule, ok := policyRules["Expiration_EndUser_Assignment"].(stable.UnifiedRoleManagementPolicyExpirationRule); ok {
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("activation_rules.0.require_approval") ||
+		d.HasChange("activation_rules.0.approval_stage") {
+
+		rule := stable.UnifiedRoleManagementPolicyApprovalRule{
+			Id: pointer.To("Approval_EndUser_Assignment"),
+			Setting: &stable.ApprovalSettings{
+				IsApprovalRequired: nullable.Value(rules.ActivationRules[0].RequireApproval),
+			},
+		}
+
+		if existingRule, ok := policyRules["Approval_EndUser_Assignment"].(stable.UnifiedRoleManagementPolicyApprovalRule); ok {
+			rule.Target = existingRule.Target
+
+			if existingRule.Setting != nil {
+				rule.Setting.ApprovalStages = existingRule.Setting.ApprovalStages
+			}
+		}
+
+		if rules.ActivationRules[0].RequireApproval && len(rules.ActivationRules[0].ApprovalStages) != 1 {
+			return nil, fmt.Errorf("require_approval is true, but no approval_stages are provided")
+		}
+
+		if d.HasChange("activation_rules.0.approval_stage") {
+			approvalStages := make([]stable.UnifiedApprovalStage, 0)
+
+			for _, stage := range rules.ActivationRules[0].ApprovalStages {
+				primaryApprovers := make([]stable.SubjectSet, 0)
+
+				for _, approver := range stage.PrimaryApprovers {
+					switch approver.Type {
+					case "singleUser":
+						primaryApprovers = append(primaryApprovers, stable.SingleUser{
+							UserId: nullable.Value(approver.ID),
+						})
+					case "groupMembers":
+						primaryApprovers = append(primaryApprovers, stable.GroupMembers{
+							GroupId: nullable.Value(approver.ID),
+						})
+					}
+				}
+
+				approvalStages = append(approvalStages, stable.UnifiedApprovalStage{
+					PrimaryApprovers: &primaryApprovers,
+				})
+			}
+
+			rule.Setting.ApprovalStages = &approvalStages
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("activation_rules.0.required_conditional_access_authentication_context") {
+		rule := stable.UnifiedRoleManagementPolicyAuthenticationContextRule{
+			Id:         pointer.To("AuthenticationContext_EndUser_Assignment"),
+			IsEnabled:  nullable.Value(rules.ActivationRules[0].RequireConditionalAccessContext != ""),
+			ClaimValue: nullable.NoZero(rules.ActivationRules[0].RequireConditionalAccessContext),
+		}
+
+		if existingRule, ok := policyRules["AuthenticationContext_EndUser_Assignment"].(stable.UnifiedRoleManagementPolicyAuthenticationContextRule); ok {
+			rule.ClaimValue = existingRule.ClaimValue
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("activation_rules.0.require_multifactor_authentication") ||
+		d.HasChange("activation_rules.0.require_justification") ||
+		d.HasChange("activation_rules.0.require_ticket_info") {
+
+		enabledRules := make([]string, 0)
+		if rules.ActivationRules[0].RequireMultiFactorAuth {
+			enabledRules = append(enabledRules, "MultiFactorAuthentication")
+		}
+		if rules.ActivationRules[0].RequireJustification {
+			enabledRules = append(enabledRules, "Justification")
+		}
+		if rules.ActivationRules[0].RequireTicketInfo {
+			enabledRules = append(enabledRules, "Ticketing")
+		}
+
+		rule := stable.UnifiedRoleManagementPolicyEnablementRule{
+			Id:           pointer.To("Enablement_EndUser_Assignment"),
+			EnabledRules: &enabledRules,
+		}
+
+		if existingRule, ok := policyRules["Enablement_EndUser_Assignment"].(stable.UnifiedRoleManagementPolicyEnablementRule); ok {
+			rule.Target = existingRule.Target
+		}
+
+		updatedRules = append(updatedRules, rule)
+	}
+
+	if d.HasChange("notification_rules.0.eligible_assignments.0.admin_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Admin_Admin_Eligibility",
+			policyRules,
+			rules.NotificationRules[0].EligibleAssignments[0].AdminNotifications[0],
+			"Admin",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.active_assignments.0.admin_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Admin_Admin_Assignment",
+			policyRules,
+			rules.NotificationRules[0].ActiveAssignments[0].AdminNotifications[0],
+			"Admin",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.eligible_activations.0.admin_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Admin_EndUser_Assignment",
+			policyRules,
+			rules.NotificationRules[0].EligibleActivations[0].AdminNotifications[0],
+			"Admin",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.eligible_assignments.0.approver_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Approver_Admin_Eligibility",
+			policyRules,
+			rules.NotificationRules[0].EligibleAssignments[0].ApproverNotifications[0],
+			"Approver",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.active_assignments.0.approver_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Approver_Admin_Assignment",
+			policyRules,
+			rules.NotificationRules[0].ActiveAssignments[0].ApproverNotifications[0],
+			"Approver",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.eligible_activations.0.approver_notifications") {
+		updatedRules = append(updatedRules,
+			expandNotificationSettings(
+				"Notification_Approver_EndUser_Assignment",
+				policyRules,
+				rules.NotificationRules[0].EligibleActivations[0].ApproverNotifications[0],
+				"Approver",
+			))
+	}
+
+	if d.HasChange("notification_rules.0.eligible_assignments.0.assignee_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Requestor_Admin_Eligibility",
+			policyRules,
+			rules.NotificationRules[0].EligibleAssignments[0].AssigneeNotifications[0],
+			"Requestor",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.active_assignments.0.assignee_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Requestor_Admin_Assignment",
+			policyRules,
+			rules.NotificationRules[0].ActiveAssignments[0].AssigneeNotifications[0],
+			"Requestor",
+		))
+	}
+
+	if d.HasChange("notification_rules.0.eligible_activations.0.assignee_notifications") {
+		updatedRules = append(updatedRules, expandNotificationSettings(
+			"Notification_Requestor_EndUser_Assignment",
+			policyRules,
+			rules.NotificationRules[0].EligibleActivations[0].AssigneeNotifications[0],
+			"Requestor",
+		))
+	}
+
+	return updatedRules, nil
+}
+
+func expandNotificationSettings(ruleId string, policyRules map[string]stable.UnifiedRoleManagementPolicyRule, data RoleManagementPolicyNotificationSettings, recipientType string) stable.UnifiedRoleManagementPolicyNotificationRule {
+	rule := stable.UnifiedRoleManagementPolicyNotificationRule{
+		Id:                         pointer.To(ruleId),
+		IsDefaultRecipientsEnabled: nullable.Value(data.DefaultRecipients),
+		NotificationLevel:          nullable.Value(data.NotificationLevel),
+		NotificationRecipients:     pointer.To(data.AdditionalRecipients),
+		NotificationType:           nullable.Value("Email"),
+		RecipientType:              nullable.Value(recipientType),
+	}
+
+	if existingRule, ok := policyRules[ruleId].(stable.UnifiedRoleManagementPolicyNotificationRule); ok {
+		rule.Target = existingRule.Target
+	}
+
+	return rule
+}
+
+func flattenNotificationSettings(rule stable.UnifiedRoleManagementPolicyNotificationRule) RoleManagementPolicyNotificationSettings {
+	return RoleManagementPolicyNotificationSettings{
+		NotificationLevel:    rule.NotificationLevel.GetOrZero(),
+		DefaultRecipients:    rule.IsDefaultRecipientsEnabled.GetOrZero(),
+		AdditionalRecipients: pointer.From(rule.NotificationRecipients),
+	}
+}
+
+func notificationRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"admin_notifications": {
+			Description: "Admin notification settings",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: notificationSettingsSchema(),
+			},
+		},
+		"approver_notifications": {
+			Description: "Approver notification settings",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: notificationSettingsSchema(),
+			},
+		},
+		"assignee_notifications": {
+			Description: "Assignee notification settings",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: notificationSettingsSchema(),
+			},
+		},
+	}
+}
+
+func notificationSettingsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"notification_level": {
+			Description:  "What level of notifications are sent",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"All", "Critical"}, false),
+		},
+		"default_recipients": {
+			Description: "Whether the default recipients are notified",
+			Type:        pluginsdk.TypeBool,
+			Required:    true,
+		},
+		"additional_recipients": {
+			Description: "The additional recipients to notify",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
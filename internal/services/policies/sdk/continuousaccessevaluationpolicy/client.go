@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package continuousaccessevaluationpolicy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// ContinuousAccessEvaluationPolicyClient provides access to the /identity/continuousAccessEvaluationPolicy endpoint,
+// which is only available in the beta API and is not currently exposed by go-azure-sdk.
+type ContinuousAccessEvaluationPolicyClient struct {
+	Client *msgraph.Client
+}
+
+func NewContinuousAccessEvaluationPolicyClientWithBaseURI(sdkApi sdkEnv.Api) (*ContinuousAccessEvaluationPolicyClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "continuousaccessevaluationpolicy", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ContinuousAccessEvaluationPolicyClient: %+v", err)
+	}
+
+	return &ContinuousAccessEvaluationPolicyClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package continuousaccessevaluationpolicy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type GetContinuousAccessEvaluationPolicyOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *beta.ContinuousAccessEvaluationPolicy
+}
+
+type GetContinuousAccessEvaluationPolicyOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+	Select    *[]string
+}
+
+func DefaultGetContinuousAccessEvaluationPolicyOperationOptions() GetContinuousAccessEvaluationPolicyOperationOptions {
+	return GetContinuousAccessEvaluationPolicyOperationOptions{}
+}
+
+func (o GetContinuousAccessEvaluationPolicyOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o GetContinuousAccessEvaluationPolicyOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	if o.Select != nil {
+		out.Select = *o.Select
+	}
+	return &out
+}
+
+func (o GetContinuousAccessEvaluationPolicyOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// GetContinuousAccessEvaluationPolicy - Get continuousAccessEvaluationPolicy. Read the properties of the continuous
+// access evaluation policy for the tenant.
+func (c ContinuousAccessEvaluationPolicyClient) GetContinuousAccessEvaluationPolicy(ctx context.Context, options GetContinuousAccessEvaluationPolicyOperationOptions) (result GetContinuousAccessEvaluationPolicyOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Path:          "/identity/continuousAccessEvaluationPolicy",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model beta.ContinuousAccessEvaluationPolicy
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
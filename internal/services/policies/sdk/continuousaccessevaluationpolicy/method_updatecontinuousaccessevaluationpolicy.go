@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package continuousaccessevaluationpolicy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type UpdateContinuousAccessEvaluationPolicyOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type UpdateContinuousAccessEvaluationPolicyOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultUpdateContinuousAccessEvaluationPolicyOperationOptions() UpdateContinuousAccessEvaluationPolicyOperationOptions {
+	return UpdateContinuousAccessEvaluationPolicyOperationOptions{}
+}
+
+func (o UpdateContinuousAccessEvaluationPolicyOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o UpdateContinuousAccessEvaluationPolicyOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o UpdateContinuousAccessEvaluationPolicyOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// UpdateContinuousAccessEvaluationPolicy - Update continuousAccessEvaluationPolicy. Update the properties of the
+// continuous access evaluation policy for the tenant.
+func (c ContinuousAccessEvaluationPolicyClient) UpdateContinuousAccessEvaluationPolicy(ctx context.Context, input beta.ContinuousAccessEvaluationPolicy, options UpdateContinuousAccessEvaluationPolicyOperationOptions) (result UpdateContinuousAccessEvaluationPolicyOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPatch,
+		OptionsObject: options,
+		Path:          "/identity/continuousAccessEvaluationPolicy",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
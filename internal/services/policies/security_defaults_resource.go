@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/identitysecuritydefaultsenforcementpolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// securityDefaultsId is the ID of the security defaults enforcement policy, which always exists in a tenant
+const securityDefaultsId = "/policies/identitySecurityDefaultsEnforcementPolicy"
+
+func securityDefaultsResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: securityDefaultsResourceCreateUpdate,
+		ReadContext:   securityDefaultsResourceRead,
+		UpdateContext: securityDefaultsResourceCreateUpdate,
+		DeleteContext: securityDefaultsResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != securityDefaultsId {
+				return fmt.Errorf("expected ID to be %q, got %q", securityDefaultsId, id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"enabled": {
+				Description: "Whether security defaults are enabled for the tenant",
+				Type:        pluginsdk.TypeBool,
+				Required:    true,
+			},
+
+			"description": {
+				Description: "The description of the security defaults policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the security defaults policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func securityDefaultsResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.SecurityDefaultsClient
+
+	properties := stable.IdentitySecurityDefaultsEnforcementPolicy{
+		IsEnabled: pointer.To(d.Get("enabled").(bool)),
+	}
+
+	if _, err := client.UpdateIdentitySecurityDefaultsEnforcementPolicy(ctx, properties, identitysecuritydefaultsenforcementpolicy.DefaultUpdateIdentitySecurityDefaultsEnforcementPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update security defaults")
+	}
+
+	d.SetId(securityDefaultsId)
+
+	return securityDefaultsResourceRead(ctx, d, meta)
+}
+
+func securityDefaultsResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.SecurityDefaultsClient
+
+	resp, err := client.GetIdentitySecurityDefaultsEnforcementPolicy(ctx, identitysecuritydefaultsenforcementpolicy.DefaultGetIdentitySecurityDefaultsEnforcementPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving security defaults")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving security defaults")
+	}
+
+	tf.Set(d, "description", policy.Description.GetOrZero())
+	tf.Set(d, "display_name", policy.DisplayName.GetOrZero())
+	tf.Set(d, "enabled", pointer.From(policy.IsEnabled))
+
+	return nil
+}
+
+func securityDefaultsResourceDelete(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) pluginsdk.Diagnostics {
+	// Security defaults cannot be deleted. Re-enabling them would fail in tenants with conditional access policies, so
+	// the current setting is left in place when this resource is destroyed.
+	log.Printf("[DEBUG] Security defaults cannot be deleted, leaving current setting in place")
+
+	return nil
+}
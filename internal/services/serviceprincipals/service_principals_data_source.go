@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/query"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -36,11 +37,12 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"client_ids": {
-				Description:  "The client IDs of the applications associated with the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The client IDs of the applications associated with the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "filter", "object_ids", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -48,11 +50,12 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_names": {
-				Description:  "The display names of the applications associated with the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The display names of the applications associated with the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "filter", "object_ids", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -60,23 +63,42 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 			},
 
 			"object_ids": {
-				Description:  "The object IDs of the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The object IDs of the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
 				},
 			},
 
+			"filter": {
+				Description:   "An OData filter expression used to find service principals. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"search": {
+				Description:   "An OData search expression used to find service principals, e.g. `displayName:contoso`. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
 			"ignore_missing": {
 				Description:   "Ignore missing service principals and return the service principals that were found. The data source will still fail if no service principals are found",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
@@ -84,8 +106,8 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"client_ids", "display_names", "object_ids", "return_all"},
+				AtLeastOneOf:  []string{"client_ids", "display_names", "filter", "object_ids", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "filter", "ignore_missing", "object_ids", "search"},
 			},
 
 			"service_principals": {
@@ -186,6 +208,8 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 	var expectedCount int
 	ignoreMissing := d.Get("ignore_missing").(bool)
 	returnAll := d.Get("return_all").(bool)
+	filterExpression := d.Get("filter").(string)
+	searchExpression := d.Get("search").(string)
 
 	fieldsToSelect := []string{
 		"accountEnabled",
@@ -202,19 +226,32 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 	}
 
 	clientIdsToSearch := tf.ExpandStringSlice(d.Get("client_ids").([]interface{}))
-	if returnAll {
-		resp, err := client.ListServicePrincipals(ctx, serviceprincipal.ListServicePrincipalsOperationOptions{Select: &fieldsToSelect})
-		if err != nil {
-			return tf.ErrorDiagF(err, "Could not retrieve service principals")
-		}
-		if resp.Model == nil {
-			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	if returnAll || filterExpression != "" || searchExpression != "" {
+		// Stream the results one page at a time, selecting only the fields we need, to keep memory usage low for large tenants
+		options := query.Options{
+			Advanced: filterExpression != "" || searchExpression != "",
+			Filter:   filterExpression,
+			Search:   query.NormalizeSearch(searchExpression),
+			Select:   fieldsToSelect,
+			Top:      query.DefaultPageSize,
 		}
-		if len(*resp.Model) == 0 {
-			return tf.ErrorDiagPathF(err, "return_all", "No service principals found")
+		if _, err := query.ListPages(ctx, client.Client, "/servicePrincipals", options, func(page []stable.ServicePrincipal) error {
+			servicePrincipals = append(servicePrincipals, page...)
+			return nil
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve service principals")
 		}
 
-		servicePrincipals = append(servicePrincipals, *resp.Model...)
+		if len(servicePrincipals) == 0 {
+			switch {
+			case filterExpression != "":
+				return tf.ErrorDiagPathF(nil, "filter", "No service principals found matching filter: %q", filterExpression)
+			case searchExpression != "":
+				return tf.ErrorDiagPathF(nil, "search", "No service principals found matching search: %q", searchExpression)
+			default:
+				return tf.ErrorDiagPathF(nil, "return_all", "No service principals found")
+			}
+		}
 
 	} else if len(clientIdsToSearch) > 0 {
 		expectedCount = len(clientIdsToSearch)
@@ -292,7 +329,7 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 	}
 
 	// Check that the right number of service principals were returned
-	if !returnAll && !ignoreMissing && len(servicePrincipals) != expectedCount {
+	if !returnAll && !ignoreMissing && filterExpression == "" && searchExpression == "" && len(servicePrincipals) != expectedCount {
 		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(servicePrincipals)), "Unexpected number of service principals returned")
 	}
 
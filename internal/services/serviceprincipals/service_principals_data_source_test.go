@@ -119,6 +119,54 @@ func TestAccServicePrincipalsDataSource_returnAll(t *testing.T) {
 	}})
 }
 
+func TestAccServicePrincipalsDataSource_byFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principals", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalsDataSource{}.byFilter(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("display_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("service_principals.#").HasValue("3"),
+		),
+	}})
+}
+
+func TestAccServicePrincipalsDataSource_bySearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principals", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalsDataSource{}.bySearch(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("display_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("service_principals.#").HasValue("3"),
+		),
+	}})
+}
+
+func (ServicePrincipalsDataSource) byFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principals" "test" {
+  filter     = "startsWith(displayName, 'acctestServicePrincipal') and endsWith(displayName, '-%[2]d')"
+  depends_on = [azuread_service_principal.testA, azuread_service_principal.testB, azuread_service_principal.testC]
+}
+`, ServicePrincipalResource{}.threeServicePrincipalsABC(data), data.RandomInteger)
+}
+
+func (ServicePrincipalsDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principals" "test" {
+  search     = "displayName:%[2]d"
+  depends_on = [azuread_service_principal.testA, azuread_service_principal.testB, azuread_service_principal.testC]
+}
+`, ServicePrincipalResource{}.threeServicePrincipalsABC(data), data.RandomInteger)
+}
+
 func (ServicePrincipalsDataSource) byDisplayNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
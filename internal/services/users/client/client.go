@@ -7,15 +7,23 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/me/stable/me"
 	userBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/beta/user"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/photo"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/sponsor"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/sdk/photovalue"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/sdk/sponsorref"
 )
 
 type Client struct {
-	ManagerClient  *manager.ManagerClient
-	MeClient       *me.MeClient
-	UserClient     *user.UserClient
-	UserClientBeta *userBeta.UserClient
+	ManagerClient    *manager.ManagerClient
+	MeClient         *me.MeClient
+	PhotoClient      *photo.PhotoClient
+	PhotoValueClient *photovalue.PhotoValueClient
+	SponsorClient    *sponsor.SponsorClient
+	SponsorRefClient *sponsorref.SponsorRefClient
+	UserClient       *user.UserClient
+	UserClientBeta   *userBeta.UserClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -31,6 +39,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(meClient.Client)
 
+	photoClient, err := photo.NewPhotoClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(photoClient.Client)
+
+	photoValueClient, err := photovalue.NewPhotoValueClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(photoValueClient.Client)
+
+	sponsorClient, err := sponsor.NewSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(sponsorClient.Client)
+
+	sponsorRefClient, err := sponsorref.NewSponsorRefClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(sponsorRefClient.Client)
+
 	userClient, err := user.NewUserClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -45,9 +77,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(userClientBeta.Client)
 
 	return &Client{
-		ManagerClient:  managerClient,
-		MeClient:       meClient,
-		UserClient:     userClient,
-		UserClientBeta: userClientBeta,
+		ManagerClient:    managerClient,
+		MeClient:         meClient,
+		PhotoClient:      photoClient,
+		PhotoValueClient: photoValueClient,
+		SponsorClient:    sponsorClient,
+		SponsorRefClient: sponsorRefClient,
+		UserClient:       userClient,
+		UserClientBeta:   userClientBeta,
 	}, nil
 }
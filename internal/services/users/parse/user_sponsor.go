@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type UserSponsorId struct {
+	ObjectSubResourceId
+	UserId    string
+	SponsorId string
+}
+
+func NewUserSponsorID(userId, sponsorId string) UserSponsorId {
+	return UserSponsorId{
+		ObjectSubResourceId: NewObjectSubResourceID(userId, "sponsor", sponsorId),
+		UserId:              userId,
+		SponsorId:           sponsorId,
+	}
+}
+
+func UserSponsorID(idString string) (*UserSponsorId, error) {
+	id, err := ObjectSubResourceID(idString, "sponsor")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Sponsor ID: %v", err)
+	}
+
+	return &UserSponsorId{
+		ObjectSubResourceId: *id,
+		UserId:              id.objectId,
+		SponsorId:           id.subId,
+	}, nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package photovalue
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// PhotoValueClient provides access to the /users/{userId}/photo and /users/{userId}/photo/$value endpoints for reading
+// and uploading the default profile photo of a user. The users/stable/photo package in go-azure-sdk only exposes these
+// operations for individual photo sizes.
+type PhotoValueClient struct {
+	Client *msgraph.Client
+}
+
+func NewPhotoValueClientWithBaseURI(sdkApi sdkEnv.Api) (*PhotoValueClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "photo", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PhotoValueClient: %+v", err)
+	}
+
+	return &PhotoValueClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package photovalue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type SetPhotoValueOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type SetPhotoValueOperationOptions struct {
+	ContentType string
+	Metadata    *odata.Metadata
+	RetryFunc   client.RequestRetryFunc
+}
+
+func DefaultSetPhotoValueOperationOptions() SetPhotoValueOperationOptions {
+	return SetPhotoValueOperationOptions{}
+}
+
+func (o SetPhotoValueOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o SetPhotoValueOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o SetPhotoValueOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// SetPhotoValue - Update profilePhoto. Update the photo for the specified user. The photo should be a JPEG, PNG or GIF
+// image no larger than 4 MB.
+func (c PhotoValueClient) SetPhotoValue(ctx context.Context, id stable.UserId, input []byte, options SetPhotoValueOperationOptions) (result SetPhotoValueOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: options.ContentType,
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: options,
+		Path:          fmt.Sprintf("%s/photo/$value", id.ID()),
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
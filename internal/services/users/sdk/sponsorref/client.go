@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sponsorref
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// SponsorRefClient provides access to the /users/{userId}/sponsors/$ref endpoints for adding and removing sponsors, which
+// are not exposed by the users/stable/sponsor package in go-azure-sdk.
+type SponsorRefClient struct {
+	Client *msgraph.Client
+}
+
+func NewSponsorRefClientWithBaseURI(sdkApi sdkEnv.Api) (*SponsorRefClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "sponsor", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SponsorRefClient: %+v", err)
+	}
+
+	return &SponsorRefClient{
+		Client: client,
+	}, nil
+}
@@ -162,6 +162,12 @@ func userDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"external_user_state_change_date": {
+				Description: "The timestamp when the `external_user_state` of the user last changed, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"fax_number": {
 				Description: "The fax number of the user",
 				Type:        pluginsdk.TypeString,
@@ -183,6 +189,13 @@ func userDataSource() *pluginsdk.Resource {
 				},
 			},
 
+			"include_sign_in_activity": {
+				Description: "Whether to retrieve the sign-in activity for the user. This requires the `AuditLog.Read.All` permission and a Microsoft Entra ID P1 or P2 license",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"job_title": {
 				Description: "The user’s job title",
 				Type:        pluginsdk.TypeString,
@@ -285,6 +298,33 @@ func userDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"sign_in_activity": {
+				Description: "The last sign-in activity for the user, when `include_sign_in_activity` is `true`",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"last_non_interactive_sign_in_date_time": {
+							Description: "The timestamp of the last non-interactive sign-in for the user, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"last_sign_in_date_time": {
+							Description: "The timestamp of the last interactive sign-in for the user, whether successful or not, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"last_successful_sign_in_date_time": {
+							Description: "The timestamp of the last successful interactive or non-interactive sign-in for the user, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"state": {
 				Description: "The state or province in the user's address",
 				Type:        pluginsdk.TypeString,
@@ -456,6 +496,7 @@ func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta int
 			"employeeOrgData",
 			"employeeType",
 			"externalUserState",
+			"externalUserStateChangeDateTime",
 			"faxNumber",
 			"givenName",
 			"id",
@@ -486,6 +527,10 @@ func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		}),
 	}
 
+	if d.Get("include_sign_in_activity").(bool) {
+		*options.Select = append(*options.Select, "signInActivity")
+	}
+
 	id := stable.NewUserID(*foundObjectId)
 	resp, err := client.GetUser(ctx, id, options)
 	if err != nil {
@@ -512,6 +557,7 @@ func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	tf.Set(d, "employee_id", u.EmployeeId.GetOrZero())
 	tf.Set(d, "employee_type", u.EmployeeType.GetOrZero())
 	tf.Set(d, "external_user_state", u.ExternalUserState.GetOrZero())
+	tf.Set(d, "external_user_state_change_date", u.ExternalUserStateChangeDateTime.GetOrZero())
 	tf.Set(d, "fax_number", u.FaxNumber.GetOrZero())
 	tf.Set(d, "given_name", u.GivenName.GetOrZero())
 	tf.Set(d, "im_addresses", pointer.From(u.ImAddresses))
@@ -533,6 +579,7 @@ func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	tf.Set(d, "preferred_language", u.PreferredLanguage.GetOrZero())
 	tf.Set(d, "proxy_addresses", pointer.From(u.ProxyAddresses))
 	tf.Set(d, "show_in_address_list", u.ShowInAddressList.GetOrZero())
+	tf.Set(d, "sign_in_activity", flattenSignInActivity(u.SignInActivity))
 	tf.Set(d, "state", u.State.GetOrZero())
 	tf.Set(d, "street_address", u.StreetAddress.GetOrZero())
 	tf.Set(d, "surname", u.Surname.GetOrZero())
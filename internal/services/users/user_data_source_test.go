@@ -109,6 +109,32 @@ func TestAccUserDataSource_byEmployeeIdNonexistent(t *testing.T) {
 	}})
 }
 
+func TestAccUserDataSource_signInActivity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+	r := UserDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: r.signInActivity(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			r.testCheckFunc(data),
+			check.That(data.ResourceName).Key("sign_in_activity.#").Exists(),
+		),
+	}})
+}
+
+func TestAccUserDataSource_guest(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UserDataSource{}.guest(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("external_user_state").HasValue("PendingAcceptance"),
+			check.That(data.ResourceName).Key("external_user_state_change_date").Exists(),
+			check.That(data.ResourceName).Key("user_type").HasValue("Guest"),
+		),
+	}})
+}
+
 func (UserDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
 	return acceptance.ComposeTestCheckFunc(
 		check.That(data.ResourceName).Key("account_enabled").Exists(),
@@ -168,6 +194,30 @@ data "azuread_user" "test" {
 `, UserResource{}.complete(data))
 }
 
+func (UserDataSource) signInActivity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user" "test" {
+  object_id                = azuread_user.test.object_id
+  include_sign_in_activity = true
+}
+`, UserResource{}.complete(data))
+}
+
+func (UserDataSource) guest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-guest-%[1]s@test.com"
+}
+
+data "azuread_user" "test" {
+  object_id = azuread_invitation.test.user_id
+}
+`, data.RandomString)
+}
+
 func (UserDataSource) byObjectIdNonexistent() string {
 	return `
 data "azuread_user" "test" {
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	userBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/beta/user"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/photo"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
@@ -25,8 +26,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/migrations"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/sdk/photovalue"
 )
 
 func userResource() *pluginsdk.Resource {
@@ -147,6 +150,14 @@ func userResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"employee_hire_date": {
+				Description:      "The date and time when the user was hired, or will start work in a future hire, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			"employee_id": {
 				Description:  "The employee identifier assigned to the user by the organisation",
 				Type:         pluginsdk.TypeString,
@@ -154,6 +165,14 @@ func userResource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 16),
 			},
 
+			"employee_leave_date_time": {
+				Description:      "The date and time when the user left, or will leave the organisation, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			"employee_type": {
 				Description:  "Captures enterprise worker type. For example, Employee, Contractor, Consultant, or Vendor.",
 				Type:         pluginsdk.TypeString,
@@ -256,6 +275,13 @@ func userResource() *pluginsdk.Resource {
 				Default:     false,
 			},
 
+			"photo": {
+				Description:  "The profile photo for the user, either as a path to a local file or as a base64 encoded image in gif, png or jpeg format",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"postal_code": {
 				Description: "The postal code for the user's postal address. The postal code is specific to the user's country/region. In the United States of America, this attribute contains the ZIP code",
 				Type:        pluginsdk.TypeString,
@@ -369,6 +395,12 @@ func userResource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"photo_hash": {
+				Description: "The SHA-256 hash of the uploaded profile photo, used to detect changes to the photo content",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"proxy_addresses": {
 				Description: "Email addresses for the user that direct to the same mailbox",
 				Type:        pluginsdk.TypeList,
@@ -395,6 +427,28 @@ func userResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff,
 		return fmt.Errorf("`consent_provided_for_minor` can only be set to %q or %q when `age_group` is %q or %q",
 			ConsentProvidedForMinorGranted, ConsentProvidedForMinorDenied, AgeGroupAdult, AgeGroupNotAdult)
 	}
+
+	// Track the content of the photo, so that changes to a file are detected even when the path is unchanged
+	if diff.NewValueKnown("photo") {
+		photoHash := ""
+		if v := diff.Get("photo").(string); v != "" {
+			_, photoData, err := userParsePhoto(v)
+			if err != nil {
+				return fmt.Errorf("parsing `photo`: %v", err)
+			}
+			photoHash = userPhotoHash(photoData)
+		}
+		if photoHash != diff.Get("photo_hash").(string) {
+			if err := diff.SetNew("photo_hash", photoHash); err != nil {
+				return fmt.Errorf("setting `photo_hash`: %v", err)
+			}
+		}
+	} else {
+		if err := diff.SetNewComputed("photo_hash"); err != nil {
+			return fmt.Errorf("setting `photo_hash`: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -402,12 +456,23 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	photoValueClient := meta.(*clients.Client).Users.PhotoValueClient
 
 	password := d.Get("password").(string)
 	if password == "" {
 		return tf.ErrorDiagPathF(errors.New("`password` is required when creating a new user"), "password", "Could not create user")
 	}
 
+	var photoContentType string
+	var photoData []byte
+	if v, ok := d.GetOk("photo"); ok && v != "" {
+		var err error
+		photoContentType, photoData, err = userParsePhoto(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "photo", "Could not load photo")
+		}
+	}
+
 	upn := d.Get("user_principal_name").(string)
 	mailNickName := d.Get("mail_nickname").(string)
 
@@ -438,6 +503,7 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		Country:                 nullable.NoZero(d.Get("country").(string)),
 		Department:              nullable.NoZero(d.Get("department").(string)),
 		DisplayName:             nullable.NoZero(d.Get("display_name").(string)),
+		EmployeeHireDate:        nullable.NoZero(d.Get("employee_hire_date").(string)),
 		EmployeeId:              nullable.NoZero(d.Get("employee_id").(string)),
 		EmployeeOrgData: &stable.EmployeeOrgData{
 			CostCenter: nullable.NoZero(d.Get("cost_center").(string)),
@@ -471,6 +537,11 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		properties.BusinessPhones = tf.ExpandStringSlicePtr(v.([]interface{}))
 	}
 
+	// Only send the leave date when configured, since this requires additional permissions
+	if v, ok := d.GetOk("employee_leave_date_time"); ok {
+		properties.EmployeeLeaveDateTime = nullable.NoZero(v.(string))
+	}
+
 	if v, ok := d.GetOk("onpremises_immutable_id"); ok {
 		properties.OnPremisesImmutableId = nullable.NoZero(v.(string))
 	}
@@ -533,6 +604,16 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
 	}
 
+	// Upload the profile photo
+	if photoContentType != "" && len(photoData) > 0 {
+		if _, err = photoValueClient.SetPhotoValue(ctx, id, photoData, photovalue.SetPhotoValueOperationOptions{
+			ContentType: photoContentType,
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "photo", "Could not upload photo for %s", id)
+		}
+		tf.Set(d, "photo_hash", userPhotoHash(photoData))
+	}
+
 	return userResourceRead(ctx, d, meta)
 }
 
@@ -540,6 +621,8 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	photoClient := meta.(*clients.Client).Users.PhotoClient
+	photoValueClient := meta.(*clients.Client).Users.PhotoValueClient
 
 	id, err := stable.ParseUserID(d.Id())
 	if err != nil {
@@ -563,6 +646,7 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		Country:                 nullable.NoZero(d.Get("country").(string)),
 		Department:              nullable.NoZero(d.Get("department").(string)),
 		DisplayName:             nullable.Value(d.Get("display_name").(string)),
+		EmployeeHireDate:        nullable.NoZero(d.Get("employee_hire_date").(string)),
 		EmployeeId:              nullable.NoZero(d.Get("employee_id").(string)),
 		EmployeeOrgData: &stable.EmployeeOrgData{
 			CostCenter: nullable.NoZero(d.Get("cost_center").(string)),
@@ -597,6 +681,10 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		properties.BusinessPhones = tf.ExpandStringSlicePtr(d.Get("business_phones").([]interface{}))
 	}
 
+	if d.HasChange("employee_leave_date_time") {
+		properties.EmployeeLeaveDateTime = nullable.NoZero(d.Get("employee_leave_date_time").(string))
+	}
+
 	if d.HasChange("mail") {
 		if mail := d.Get("mail").(string); mail != "" {
 			properties.Mail = nullable.NoZero(mail)
@@ -646,6 +734,26 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("photo") || d.HasChange("photo_hash") {
+		if v := d.Get("photo").(string); v != "" {
+			photoContentType, photoData, err := userParsePhoto(v)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "photo", "Could not load photo")
+			}
+			if _, err = photoValueClient.SetPhotoValue(ctx, *id, photoData, photovalue.SetPhotoValueOperationOptions{
+				ContentType: photoContentType,
+			}); err != nil {
+				return tf.ErrorDiagPathF(err, "photo", "Could not upload photo for %s", id)
+			}
+			tf.Set(d, "photo_hash", userPhotoHash(photoData))
+		} else {
+			if resp, err := photoClient.DeletePhoto(ctx, *id, photo.DefaultDeletePhotoOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagPathF(err, "photo", "Could not remove photo for %s", id)
+			}
+			tf.Set(d, "photo_hash", "")
+		}
+	}
+
 	return userResourceRead(ctx, d, meta)
 }
 
@@ -653,6 +761,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	photoValueClient := meta.(*clients.Client).Users.PhotoValueClient
 
 	id, err := stable.ParseUserID(d.Id())
 	if err != nil {
@@ -707,6 +816,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 			"consentProvidedForMinor",
 			"country",
 			"department",
+			"employeeHireDate",
 			"employeeId",
 			"employeeOrgData",
 			"employeeType",
@@ -738,6 +848,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	tf.Set(d, "consent_provided_for_minor", uExtra.ConsentProvidedForMinor.GetOrZero())
 	tf.Set(d, "country", uExtra.Country.GetOrZero())
 	tf.Set(d, "department", uExtra.Department.GetOrZero())
+	tf.Set(d, "employee_hire_date", uExtra.EmployeeHireDate.GetOrZero())
 	tf.Set(d, "employee_id", uExtra.EmployeeId.GetOrZero())
 	tf.Set(d, "employee_type", uExtra.EmployeeType.GetOrZero())
 	tf.Set(d, "external_user_state", uExtra.ExternalUserState.GetOrZero())
@@ -750,10 +861,13 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	tf.Set(d, "street_address", uExtra.StreetAddress.GetOrZero())
 	tf.Set(d, "usage_location", uExtra.UsageLocation.GetOrZero())
 
+	costCenter, division := "", ""
 	if orgData := uExtra.EmployeeOrgData; orgData != nil {
-		tf.Set(d, "cost_center", orgData.CostCenter.GetOrZero())
-		tf.Set(d, "division", orgData.Division.GetOrZero())
+		costCenter = orgData.CostCenter.GetOrZero()
+		division = orgData.Division.GetOrZero()
 	}
+	tf.Set(d, "cost_center", costCenter)
+	tf.Set(d, "division", division)
 
 	disableStrongPassword := false
 	disablePasswordExpiration := false
@@ -789,6 +903,33 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 
 	tf.Set(d, "show_in_address_list", uBeta.ShowInAddressList.GetOrZero())
 
+	// Reading `employeeLeaveDateTime` requires the `User-LifeCycleInfo.Read.All` permission, so it's retrieved separately
+	optionsLeaveDate := user.GetUserOperationOptions{
+		Select: &[]string{"employeeLeaveDateTime"},
+	}
+	respLeaveDate, err := client.GetUser(ctx, *id, optionsLeaveDate)
+	if err != nil {
+		if !response.WasForbidden(respLeaveDate.HttpResponse) {
+			return tf.ErrorDiagF(err, "Retrieving employee leave date for %s", id)
+		}
+		log.Printf("[DEBUG] Insufficient permissions to retrieve employee leave date for %s - skipping", id)
+	} else if respLeaveDate.Model != nil {
+		tf.Set(d, "employee_leave_date_time", respLeaveDate.Model.EmployeeLeaveDateTime.GetOrZero())
+	}
+
+	// The photo content can't be reliably compared, since it may be transformed by the API, so only check that it exists
+	photoHash := d.Get("photo_hash").(string)
+	if photoHash != "" {
+		photoResp, err := photoValueClient.GetProfilePhoto(ctx, *id, photovalue.DefaultGetProfilePhotoOperationOptions())
+		if err != nil {
+			if !response.WasNotFound(photoResp.HttpResponse) {
+				return tf.ErrorDiagF(err, "Retrieving photo for %s", id)
+			}
+			photoHash = ""
+		}
+	}
+	tf.Set(d, "photo_hash", photoHash)
+
 	// Retrieve the user's manager
 	managerId := ""
 	managerResp, err := managerClient.GetManager(ctx, *id, manager.DefaultGetManagerOperationOptions())
@@ -80,6 +80,36 @@ func TestAccUser_update(t *testing.T) {
 	})
 }
 
+func TestAccUser_photo(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("photo_hash").HasValue(""),
+			),
+		},
+		{
+			Config: r.withPhoto(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("photo_hash").Exists(),
+			),
+		},
+		data.ImportStep("force_password_change", "password", "photo", "photo_hash"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("photo_hash").HasValue(""),
+			),
+		},
+	})
+}
+
 func TestAccUser_threeUsersABC(t *testing.T) {
 	dataA := acceptance.BuildTestData(t, "azuread_user", "testA")
 	dataB := acceptance.BuildTestData(t, "azuread_user", "testB")
@@ -228,6 +258,7 @@ resource "azuread_user" "test" {
   department                 = "acctestUser-%[1]d-Dept"
   display_name               = "acctestUser-%[1]d-DisplayName"
   division                   = "acctestUser-%[1]d-Division"
+  employee_hire_date         = "2020-01-01T00:00:00Z"
   employee_id                = "%[3]s%[3]s"
   employee_type              = "Contractor"
   fax_number                 = "(555) 555-5555"
@@ -248,6 +279,23 @@ resource "azuread_user" "test" {
 `, data.RandomInteger, data.RandomPassword, data.RandomString)
 }
 
+func (UserResource) withPhoto(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  photo               = "iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAIAAACQkWg2AAAAFklEQVR42mOI8XtKEmIY1TCqYfhqAACVzo8QcdorugAAAABJRU5ErkJggg=="
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
 func (UserResource) threeUsersABC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/sponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/sdk/sponsorref"
+)
+
+const userSponsorResourceName = "azuread_user_sponsor"
+
+func userSponsorResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userSponsorResourceCreate,
+		ReadContext:   userSponsorResourceRead,
+		DeleteContext: userSponsorResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.UserSponsorID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user to assign the sponsor to",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"sponsor_object_id": {
+				Description:  "The object ID of the sponsor. Supported object types are Users or Groups",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func userSponsorResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.SponsorClient
+	refClient := meta.(*clients.Client).Users.SponsorRefClient
+	userClient := meta.(*clients.Client).Users.UserClient
+
+	id := stable.NewUserIdSponsorID(d.Get("user_object_id").(string), d.Get("sponsor_object_id").(string))
+	userId := stable.NewUserID(id.UserId)
+	resourceId := parse.NewUserSponsorID(id.UserId, id.DirectoryObjectId)
+
+	tf.LockByName(userSponsorResourceName, id.UserId)
+	defer tf.UnlockByName(userSponsorResourceName, id.UserId)
+
+	if resp, err := userClient.GetUser(ctx, userId, user.DefaultGetUserOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagPathF(err, "user_object_id", "Retrieving %s", userId)
+	}
+
+	existing, err := userGetSponsor(ctx, client, id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing sponsor for %s", userId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag(userSponsorResourceName, resourceId.String())
+	}
+
+	sponsorRef := stable.ReferenceCreate{
+		ODataId: pointer.To(refClient.Client.BaseUri + stable.NewDirectoryObjectID(id.DirectoryObjectId).ID()),
+	}
+
+	if resp, err := refClient.AddSponsorRef(ctx, userId, sponsorRef, sponsorref.DefaultAddSponsorRefOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(err, "sponsor_object_id", "Sponsor with object ID %q was not found", id.DirectoryObjectId)
+		}
+		return tf.ErrorDiagF(err, "Adding %s", id)
+	}
+
+	d.SetId(resourceId.String())
+
+	// Wait for the sponsor reference to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		s, err := userGetSponsor(ctx, client, id)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(s != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be added", id)
+	}
+
+	return userSponsorResourceRead(ctx, d, meta)
+}
+
+func userSponsorResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.SponsorClient
+
+	resourceId, err := parse.UserSponsorID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Sponsor ID %q", d.Id())
+	}
+	id := stable.NewUserIdSponsorID(resourceId.UserId, resourceId.SponsorId)
+
+	if s, err := userGetSponsor(ctx, client, id); err != nil {
+		return tf.ErrorDiagF(err, "Retrieving sponsor %q for user with object ID: %q", id.DirectoryObjectId, id.UserId)
+	} else if s == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "sponsor_object_id", id.DirectoryObjectId)
+	tf.Set(d, "user_object_id", id.UserId)
+
+	return nil
+}
+
+func userSponsorResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.SponsorClient
+	refClient := meta.(*clients.Client).Users.SponsorRefClient
+
+	resourceId, err := parse.UserSponsorID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Sponsor ID %q", d.Id())
+	}
+	id := stable.NewUserIdSponsorID(resourceId.UserId, resourceId.SponsorId)
+
+	tf.LockByName(userSponsorResourceName, id.UserId)
+	defer tf.UnlockByName(userSponsorResourceName, id.UserId)
+
+	if resp, err := refClient.RemoveSponsorRef(ctx, id, sponsorref.DefaultRemoveSponsorRefOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s", id)
+	}
+
+	// Wait for the sponsor reference to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		s, err := userGetSponsor(ctx, client, id)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(s != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
+
+// userGetSponsor returns the sponsor with the specified ID if it is assigned to the user, or nil if it is not.
+func userGetSponsor(ctx context.Context, client *sponsor.SponsorClient, id stable.UserIdSponsorId) (stable.DirectoryObject, error) {
+	options := sponsor.ListSponsorsOperationOptions{
+		Select: pointer.To([]string{"id"}),
+	}
+
+	resp, err := client.ListSponsors(ctx, stable.NewUserID(id.UserId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model != nil {
+		for _, s := range *resp.Model {
+			if strings.EqualFold(pointer.From(s.DirectoryObject().Id), id.DirectoryObjectId) {
+				return s, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/sponsor"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserSponsorResource struct{}
+
+func TestAccUserSponsor_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_sponsor", "test")
+	r := UserSponsorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsor_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserSponsor_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_sponsor", "test")
+	r := UserSponsorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.group(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsor_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserSponsor_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_sponsor", "test")
+	r := UserSponsorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserSponsorResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.SponsorClient
+
+	id, err := parse.UserSponsorID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing User Sponsor ID: %v", err)
+	}
+
+	resp, err := client.ListSponsors(ctx, stable.NewUserID(id.UserId), sponsor.DefaultListSponsorsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve sponsor %q (user ID: %q): %+v", id.SponsorId, id.UserId, err)
+	}
+
+	if resp.Model != nil {
+		for _, s := range *resp.Model {
+			if pointer.From(s.DirectoryObject().Id) == id.SponsorId {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (UserSponsorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-guest-%[1]s@test.com"
+}
+
+resource "azuread_user" "sponsor" {
+  user_principal_name = "acctestUser.%[2]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d-A"
+  password            = "%[3]s"
+}
+`, data.RandomString, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserSponsorResource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_sponsor" "test" {
+  user_object_id    = azuread_invitation.test.user_id
+  sponsor_object_id = azuread_user.sponsor.object_id
+}
+`, r.template(data))
+}
+
+func (r UserSponsorResource) group(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "sponsor" {
+  display_name     = "acctestGroup-%[2]d"
+  security_enabled = true
+}
+
+resource "azuread_user_sponsor" "test" {
+  user_object_id    = azuread_invitation.test.user_id
+  sponsor_object_id = azuread_group.sponsor.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r UserSponsorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_sponsor" "import" {
+  user_object_id    = azuread_user_sponsor.test.user_object_id
+  sponsor_object_id = azuread_user_sponsor.test.sponsor_object_id
+}
+`, r.user(data))
+}
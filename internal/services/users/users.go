@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+func flattenSignInActivity(in *stable.SignInActivity) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"last_non_interactive_sign_in_date_time": in.LastNonInteractiveSignInDateTime.GetOrZero(),
+			"last_sign_in_date_time":                 in.LastSignInDateTime.GetOrZero(),
+			"last_successful_sign_in_date_time":      in.LastSuccessfulSignInDateTime.GetOrZero(),
+		},
+	}
+}
+
+// userParsePhoto returns the content type and data for a profile photo, which can be specified either as the path to a
+// local file, or as a base64 encoded image.
+func userParsePhoto(photo string) (string, []byte, error) {
+	var photoData []byte
+	if info, err := os.Stat(photo); err == nil && !info.IsDir() {
+		if photoData, err = os.ReadFile(photo); err != nil {
+			return "", nil, fmt.Errorf("reading file %q: %v", photo, err)
+		}
+	} else if photoData, err = base64.StdEncoding.DecodeString(strings.TrimSpace(photo)); err != nil {
+		return "", nil, fmt.Errorf("value is neither a path to an existing file, nor valid base64 encoded data")
+	}
+
+	contentType := http.DetectContentType(photoData)
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", nil, fmt.Errorf("unrecognised MIME type detected: %q", contentType)
+	}
+
+	return contentType, photoData, nil
+}
+
+func userPhotoHash(photoData []byte) string {
+	sum := sha256.Sum256(photoData)
+	return hex.EncodeToString(sum[:])
+}
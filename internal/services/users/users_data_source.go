@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/query"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -33,11 +34,12 @@ func usersData() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"employee_ids": {
-				Description:  "The employee identifier assigned to the user by the organisation",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The employee identifier assigned to the user by the organisation",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -45,11 +47,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"mail_nicknames": {
-				Description:  "The email aliases of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The email aliases of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "filter", "mails", "object_ids", "return_all", "search", "user_principal_names"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -57,11 +60,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"mails": {
-				Description:  "The SMTP address of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The SMTP address of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "filter", "mail_nicknames", "object_ids", "return_all", "search", "user_principal_names"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -69,11 +73,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"object_ids": {
-				Description:  "The object IDs of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The object IDs of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "filter", "mail_nicknames", "mails", "return_all", "search", "user_principal_names"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -81,23 +86,42 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"user_principal_names": {
-				Description:  "The user principal names (UPNs) of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The user principal names (UPNs) of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
 
+			"filter": {
+				Description:   "An OData filter expression used to find users. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "mail_nicknames", "mails", "object_ids", "return_all", "user_principal_names"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"search": {
+				Description:   "An OData search expression used to find users, e.g. `displayName:smith`. This is evaluated as an advanced query",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "mail_nicknames", "mails", "object_ids", "return_all", "user_principal_names"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
 			"ignore_missing": {
 				Description:   "Ignore missing users and return users that were found. The data source will still fail if no users are found",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
@@ -105,8 +129,8 @@ func usersData() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				AtLeastOneOf:  []string{"employee_ids", "filter", "mail_nicknames", "mails", "object_ids", "return_all", "search", "user_principal_names"},
+				ConflictsWith: []string{"employee_ids", "filter", "ignore_missing", "mail_nicknames", "mails", "object_ids", "search", "user_principal_names"},
 			},
 
 			"users": {
@@ -194,6 +218,8 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 	var expectedCount int
 	ignoreMissing := d.Get("ignore_missing").(bool)
 	returnAll := d.Get("return_all").(bool)
+	filterExpression := d.Get("filter").(string)
+	searchExpression := d.Get("search").(string)
 
 	// Users API changes which fields it sends by default, so we explicitly select the fields we want, to guard against this
 	fieldsToSelect := []string{
@@ -211,19 +237,32 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 		"userType",
 	}
 
-	if returnAll {
-		resp, err := client.ListUsers(ctx, user.ListUsersOperationOptions{Select: &fieldsToSelect})
-		if err != nil {
-			return tf.ErrorDiagF(err, "Could not retrieve users")
+	if returnAll || filterExpression != "" || searchExpression != "" {
+		// Stream the results one page at a time, selecting only the fields we need, to keep memory usage low for large tenants
+		options := query.Options{
+			Advanced: filterExpression != "" || searchExpression != "",
+			Filter:   filterExpression,
+			Search:   query.NormalizeSearch(searchExpression),
+			Select:   fieldsToSelect,
+			Top:      query.DefaultPageSize,
 		}
-		if resp.Model == nil {
-			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
-		}
-		if len(*resp.Model) == 0 {
-			return tf.ErrorDiagPathF(err, "return_all", "No users found")
+		if _, err := query.ListPages(ctx, client.Client, "/users", options, func(page []stable.User) error {
+			foundUsers = append(foundUsers, page...)
+			return nil
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve users")
 		}
 
-		foundUsers = append(foundUsers, *resp.Model...)
+		if len(foundUsers) == 0 {
+			switch {
+			case filterExpression != "":
+				return tf.ErrorDiagPathF(nil, "filter", "No users found matching filter: %q", filterExpression)
+			case searchExpression != "":
+				return tf.ErrorDiagPathF(nil, "search", "No users found matching search: %q", searchExpression)
+			default:
+				return tf.ErrorDiagPathF(nil, "return_all", "No users found")
+			}
+		}
 
 	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
 		expectedCount = len(upns)
@@ -360,7 +399,7 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 	}
 
 	// Check that the right number of users were returned
-	if !returnAll && !ignoreMissing && len(foundUsers) != expectedCount {
+	if !returnAll && !ignoreMissing && filterExpression == "" && searchExpression == "" && len(foundUsers) != expectedCount {
 		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(foundUsers)), "Unexpected number of users returned")
 	}
 
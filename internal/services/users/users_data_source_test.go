@@ -195,6 +195,32 @@ func TestAccUsersDataSource_returnAll(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_byFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UsersDataSource{}.byFilter(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").HasValue("2"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("users.#").HasValue("2"),
+		),
+	}})
+}
+
+func TestAccUsersDataSource_bySearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UsersDataSource{}.bySearch(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("users.#").HasValue("3"),
+		),
+	}})
+}
+
 func (UsersDataSource) byUserPrincipalNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -326,6 +352,28 @@ data "azuread_users" "test" {
 `, UserResource{}.threeUsersABC(data))
 }
 
+func (UsersDataSource) byFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  filter     = "startsWith(displayName, 'acctestUser-%[2]d-') and employeeId ne null"
+  depends_on = [azuread_user.testA, azuread_user.testB, azuread_user.testC]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
+func (UsersDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  search     = "displayName:%[2]d"
+  depends_on = [azuread_user.testA, azuread_user.testB, azuread_user.testC]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
 func (UsersDataSource) noNames() string {
 	return `
 data "azuread_users" "test" {